@@ -47,9 +47,20 @@ func main() {
 		TLSEnabled:                    cliArgs.HTTPTLSEnabled,
 		TLSCertFile:                   cliArgs.HTTPTLSCertFile,
 		TLSKeyFile:                    cliArgs.HTTPTLSKeyFile,
+		Stateful:                      cliArgs.HTTPStateful,
+		SessionIdleTimeout:            cliArgs.HTTPSessionIdleTimeout,
+		MaxSessions:                   cliArgs.HTTPMaxSessions,
 		AuthHeaderName:                cliArgs.AuthHeaderName,
 		AllowUnauthenticatedPing:      cliArgs.HTTPAllowUnauthenticatedPing,
 		AllowUnauthenticatedToolsList: cliArgs.HTTPAllowUnauthenticatedToolsList,
+		AllowedDatabases:              cliArgs.AllowedDatabases,
+		SchemaRichOutput:              cliArgs.SchemaRichOutput,
+		SchemaCacheTTL:                cliArgs.SchemaCacheTTL,
+		MaxResultRows:                 cliArgs.MaxResultRows,
+		WriteConfirmationThreshold:    cliArgs.WriteConfirmationThreshold,
+		WriteConfirmationFallback:     cliArgs.WriteConfirmationFallback,
+		CapabilityRefreshInterval:     cliArgs.CapabilityRefreshInterval,
+		SchemaWatchInterval:           cliArgs.SchemaWatchInterval,
 	})
 	if err != nil {
 		// Can't use logger here yet, so just print to stderr
@@ -87,8 +87,8 @@ func (a *Analytics) NewStartupEvent(transportMode config.TransportMode, tlsEnabl
 		TransportMode:  transportMode,
 	}
 
-	// Only include TLS field for HTTP mode (omitted for STDIO via omitempty tag with nil pointer)
-	if props.TransportMode == config.TransportModeHTTP {
+	// Only include TLS field for HTTP and SSE mode (omitted for STDIO via omitempty tag with nil pointer)
+	if props.TransportMode.IsHTTP() {
 		props.TLSEnabled = &tlsEnabled
 	}
 
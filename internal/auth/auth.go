@@ -3,7 +3,13 @@
 
 package auth
 
-import "context"
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
 
 type contextKey string
 
@@ -44,3 +50,36 @@ func HasAuth(ctx context.Context) bool {
 	_, okBearer := GetBearerToken(ctx)
 	return okBasic || okBearer
 }
+
+// principalKey keys the credential hashes of Principal, it is random per process so the hashes
+// cannot be compared with precomputed ones.
+var principalKey = newPrincipalKey()
+
+func newPrincipalKey() []byte {
+	key := make([]byte, 32)
+	// rand.Read never returns an error, it crashes the program on failure
+	_, _ = rand.Read(key)
+	return key
+}
+
+// Principal identifies the credentials present in the context, to scope per user data such as caches and sessions.
+// The credentials are not validated when a request is received, so the principal covers the whole credentials,
+// the password of Basic auth included: a wrong password is another principal, and never shares the data of the user.
+// The credentials are hashed with a keyed hash so they are never kept in memory as is.
+// It returns an empty string when the context has no credentials (STDIO mode uses the driver credentials).
+func Principal(ctx context.Context) string {
+	if token, ok := GetBearerToken(ctx); ok {
+		return "bearer:" + credentialsHash(token)
+	}
+	if user, pass, ok := GetBasicAuthCredentials(ctx); ok {
+		// The user is not ambiguous with the password, as a Basic auth user cannot contain ':'
+		return "basic:" + credentialsHash(user+":"+pass)
+	}
+	return ""
+}
+
+func credentialsHash(credentials string) string {
+	mac := hmac.New(sha256.New, principalKey)
+	mac.Write([]byte(credentials))
+	return hex.EncodeToString(mac.Sum(nil))
+}
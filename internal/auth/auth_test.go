@@ -5,6 +5,7 @@ package auth
 
 import (
 	"context"
+	"strings"
 	"testing"
 )
 
@@ -104,3 +105,38 @@ func TestHasAuthCredentials(t *testing.T) {
 		}
 	})
 }
+
+func TestPrincipal(t *testing.T) {
+	t.Run("with basic auth", func(t *testing.T) {
+		ctx := WithBasicAuth(context.Background(), "user", "pass")
+
+		principal := Principal(ctx)
+		if !strings.HasPrefix(principal, "basic:") || strings.Contains(principal, "pass") {
+			t.Errorf("Expected a hashed basic principal, got %q", principal)
+		}
+		if same := Principal(WithBasicAuth(context.Background(), "user", "pass")); same != principal {
+			t.Errorf("Expected the same credentials to give the same principal, got %q and %q", principal, same)
+		}
+		if other := Principal(WithBasicAuth(context.Background(), "user", "wrong")); other == principal {
+			t.Error("Expected a wrong password to give a different principal")
+		}
+	})
+
+	t.Run("with bearer token", func(t *testing.T) {
+		ctx := WithBearerToken(context.Background(), "token")
+
+		principal := Principal(ctx)
+		if !strings.HasPrefix(principal, "bearer:") || strings.Contains(principal, "token") {
+			t.Errorf("Expected a hashed bearer principal, got %q", principal)
+		}
+		if other := Principal(WithBearerToken(context.Background(), "other-token")); other == principal {
+			t.Error("Expected different tokens to give different principals")
+		}
+	})
+
+	t.Run("with no auth", func(t *testing.T) {
+		if principal := Principal(context.Background()); principal != "" {
+			t.Errorf("Expected no principal, got %q", principal)
+		}
+	})
+}
@@ -29,16 +29,27 @@ Options:
   --read-only <BOOLEAN>               Enable read-only mode: true or false (overrides NEO4J_MCP_READ_ONLY)
   --telemetry <BOOLEAN>               Enable telemetry: true or false (overrides NEO4J_MCP_TELEMETRY)
   --schema-sample-size <INT>          Number of nodes to sample for schema inference (overrides NEO4J_MCP_SCHEMA_SAMPLE_SIZE)
-  --transport-mode <MODE>             MCP transport mode: 'stdio' or 'http' (overrides NEO4J_MCP_TRANSPORT_MODE)
+  --schema-rich-output <BOOLEAN>      Include indexes, constraints and counts in get-schema by default (overrides NEO4J_MCP_SCHEMA_RICH_OUTPUT)
+  --schema-cache-ttl <DURATION>       How long get-schema results are cached, e.g. 10m; 0 disables the cache (overrides NEO4J_MCP_SCHEMA_CACHE_TTL)
+  --max-result-rows <INT>             Maximum number of rows returned by the query tools; 0 for no limit (overrides NEO4J_MCP_MAX_RESULT_ROWS)
+  --write-confirmation-threshold <INT> Number of changes above which write-cypher asks the user to confirm; 0 disables it (overrides NEO4J_MCP_WRITE_CONFIRMATION_THRESHOLD)
+  --write-confirmation-fallback <MODE> 'allow' or 'deny' the writes above the threshold when the client cannot confirm them (overrides NEO4J_MCP_WRITE_CONFIRMATION_FALLBACK)
+  --capability-refresh-interval <DURATION> How often GDS, APOC, vector index support and the edition are probed again, e.g. 1m; 0 probes them once (overrides NEO4J_MCP_CAPABILITY_REFRESH_INTERVAL)
+  --schema-watch-interval <DURATION>  How often the schema is checked for the resource subscriptions, e.g. 1m; 0 disables the subscriptions (overrides NEO4J_MCP_SCHEMA_WATCH_INTERVAL)
+  --transport-mode <MODE>             MCP transport mode: 'stdio', 'http' or 'sse' (overrides NEO4J_MCP_TRANSPORT_MODE)
   --http-port <PORT>                  HTTP server port (overrides NEO4J_MCP_HTTP_PORT)
   --http-host <HOST>                  HTTP server host (overrides NEO4J_MCP_HTTP_HOST)
   --http-allowed-origins <ORIGINS>    Comma-separated list of allowed CORS origins (overrides NEO4J_MCP_HTTP_ALLOWED_ORIGINS)
   --http-tls-enabled <BOOLEAN>        Enable TLS/HTTPS for HTTP server: true or false (overrides NEO4J_MCP_HTTP_TLS_ENABLED)
   --http-tls-cert-file <PATH>         Path to TLS certificate file (overrides NEO4J_MCP_HTTP_TLS_CERT_FILE)
   --http-tls-key-file <PATH>          Path to TLS private key file (overrides NEO4J_MCP_HTTP_TLS_KEY_FILE)
+  --http-stateful <BOOLEAN>           Issue Mcp-Session-Id and keep per-session settings: true or false (overrides NEO4J_MCP_HTTP_STATEFUL)
+  --http-session-idle-timeout <DURATION> How long an unused stateful session is kept, e.g. 1h; 0 keeps it (overrides NEO4J_MCP_HTTP_SESSION_IDLE_TIMEOUT)
+  --http-max-sessions <INT>           Maximum number of stateful sessions, new sessions are refused beyond it (overrides NEO4J_MCP_HTTP_MAX_SESSIONS)
   --http-auth-header-name <HEADER>    Name of the HTTP header to read auth credentials from (overrides NEO4J_MCP_HTTP_AUTH_HEADER_NAME)
   --http-allow-unauthenticated-ping <BOOLEAN> Allow unauthenticated ping (overrides NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_PING)
   --http-allow-unauthenticated-tools-list <BOOLEAN> Allow unauthenticated tools/list (overrides NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_TOOLS_LIST)
+  --allowed-databases <DATABASES>     Comma-separated list of additional databases tools may target, '*' for all (overrides NEO4J_MCP_ALLOWED_DATABASES)
 
 Required Environment Variables (STDIO mode):
   NEO4J_MCP_URI       Neo4j database URI
@@ -50,6 +61,13 @@ Optional Environment Variables:
   NEO4J_MCP_TELEMETRY Enable/disable telemetry (default: true)
   NEO4J_MCP_READ_ONLY Enable read-only mode (default: false)
   NEO4J_MCP_SCHEMA_SAMPLE_SIZE Number of nodes to sample for schema inference (default: 100)
+  NEO4J_MCP_SCHEMA_RICH_OUTPUT Include indexes, constraints and counts in get-schema by default (default: false)
+  NEO4J_MCP_SCHEMA_CACHE_TTL How long get-schema results are cached, e.g. 10m (default: 0, no cache)
+  NEO4J_MCP_MAX_RESULT_ROWS Maximum number of rows returned by the query tools (default: 0, no limit)
+  NEO4J_MCP_WRITE_CONFIRMATION_THRESHOLD Number of changes above which write-cypher asks the user to confirm (default: 100, 0 disables it)
+  NEO4J_MCP_WRITE_CONFIRMATION_FALLBACK 'allow' or 'deny' the writes above the threshold when the client cannot confirm them (default: allow)
+  NEO4J_MCP_CAPABILITY_REFRESH_INTERVAL How often GDS, APOC, vector index support and the edition are probed again (default: 5m, 0 probes them once)
+  NEO4J_MCP_SCHEMA_WATCH_INTERVAL How often the schema is checked for the resource subscriptions (default: 30s, 0 disables the subscriptions)
   NEO4J_MCP_LOG_LEVEL Log level (default: info)
   NEO4J_MCP_LOG_FORMAT Log format: text or json (default: text)
   NEO4J_MCP_TRANSPORT_MODE MCP transport mode (default: stdio)
@@ -59,9 +77,13 @@ Optional Environment Variables:
   NEO4J_MCP_HTTP_TLS_ENABLED Enable TLS/HTTPS for HTTP server (default: false)
   NEO4J_MCP_HTTP_TLS_CERT_FILE Path to TLS certificate file (required when TLS is enabled)
   NEO4J_MCP_HTTP_TLS_KEY_FILE Path to TLS private key file (required when TLS is enabled)
+  NEO4J_MCP_HTTP_STATEFUL Issue Mcp-Session-Id and keep per-session settings (default: false, stateless)
+  NEO4J_MCP_HTTP_SESSION_IDLE_TIMEOUT How long an unused stateful session is kept (default: 30m, 0 keeps it)
+  NEO4J_MCP_HTTP_MAX_SESSIONS Maximum number of stateful sessions (default: 1000)
   NEO4J_MCP_HTTP_AUTH_HEADER_NAME Name of the HTTP header to read auth credentials from (default: Authorization)
   NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_PING Allow unauthenticated ping health checks (default: false)
   NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_TOOLS_LIST Allow unauthenticated tool listing (default: false)
+  NEO4J_MCP_ALLOWED_DATABASES Comma-separated list of additional databases tools may target, '*' for all (default: only NEO4J_MCP_DATABASE)
 
 Deprecated environment variables and --neo4j-* flags remain accepted in v1 and emit a warning. They will be removed in v2. The deprecated environment aliases are the previous unscoped names shown in the project changelog.
 
@@ -91,9 +113,20 @@ type Args struct {
 	HTTPTLSEnabled                    string
 	HTTPTLSCertFile                   string
 	HTTPTLSKeyFile                    string
+	HTTPStateful                      string
+	HTTPSessionIdleTimeout            string
+	HTTPMaxSessions                   string
 	AuthHeaderName                    string
 	HTTPAllowUnauthenticatedPing      string
 	HTTPAllowUnauthenticatedToolsList string
+	AllowedDatabases                  string
+	SchemaRichOutput                  string
+	SchemaCacheTTL                    string
+	MaxResultRows                     string
+	WriteConfirmationThreshold        string
+	WriteConfirmationFallback         string
+	CapabilityRefreshInterval         string
+	SchemaWatchInterval               string
 }
 
 // this is a list of known configuration flags to be skipped in HandleArgs
@@ -133,6 +166,17 @@ var argsSlice = []string{
 	"--neo4j-http-allow-unauthenticated-ping",
 	"--http-allow-unauthenticated-tools-list",
 	"--neo4j-http-allow-unauthenticated-tools-list",
+	"--allowed-databases",
+	"--schema-rich-output",
+	"--schema-cache-ttl",
+	"--max-result-rows",
+	"--write-confirmation-threshold",
+	"--write-confirmation-fallback",
+	"--capability-refresh-interval",
+	"--schema-watch-interval",
+	"--http-stateful",
+	"--http-session-idle-timeout",
+	"--http-max-sessions",
 }
 
 // ParseConfigFlags parses CLI flags and returns configuration values.
@@ -164,7 +208,7 @@ func ParseConfigFlags() *Args {
 	neo4jTelemetry := flag.String("neo4j-telemetry", "", "Deprecated alias for --telemetry")
 	schemaSampleSize := flag.String("schema-sample-size", "", "Number of nodes to sample for schema inference (overrides NEO4J_MCP_SCHEMA_SAMPLE_SIZE env var)")
 	neo4jSchemaSampleSize := flag.String("neo4j-schema-sample-size", "", "Deprecated alias for --schema-sample-size")
-	transportMode := flag.String("transport-mode", "", "MCP transport mode: stdio or http (overrides NEO4J_MCP_TRANSPORT_MODE env var)")
+	transportMode := flag.String("transport-mode", "", "MCP transport mode: stdio, http or sse (overrides NEO4J_MCP_TRANSPORT_MODE env var)")
 	neo4jTransportMode := flag.String("neo4j-transport-mode", "", "Deprecated alias for --transport-mode")
 	httpPort := flag.String("http-port", "", "HTTP server port (overrides NEO4J_MCP_HTTP_PORT env var)")
 	neo4jHTTPPort := flag.String("neo4j-http-port", "", "Deprecated alias for --http-port")
@@ -178,12 +222,23 @@ func ParseConfigFlags() *Args {
 	neo4jHTTPTLSCertFile := flag.String("neo4j-http-tls-cert-file", "", "Deprecated alias for --http-tls-cert-file")
 	httpTLSKeyFile := flag.String("http-tls-key-file", "", "Path to TLS private key file (overrides NEO4J_MCP_HTTP_TLS_KEY_FILE env var)")
 	neo4jHTTPTLSKeyFile := flag.String("neo4j-http-tls-key-file", "", "Deprecated alias for --http-tls-key-file")
+	httpStateful := flag.String("http-stateful", "", "Issue Mcp-Session-Id and keep per-session settings: true or false (overrides NEO4J_MCP_HTTP_STATEFUL env var)")
+	httpSessionIdleTimeout := flag.String("http-session-idle-timeout", "", "How long an unused stateful session is kept, e.g. 1h; 0 keeps it (overrides NEO4J_MCP_HTTP_SESSION_IDLE_TIMEOUT env var)")
+	httpMaxSessions := flag.String("http-max-sessions", "", "Maximum number of stateful sessions, new sessions are refused beyond it (overrides NEO4J_MCP_HTTP_MAX_SESSIONS env var)")
 	authHeaderName := flag.String("http-auth-header-name", "", "Name of the HTTP header to read auth credentials from (overrides NEO4J_MCP_HTTP_AUTH_HEADER_NAME env var)")
 	neo4jAuthHeaderName := flag.String("neo4j-http-auth-header-name", "", "Deprecated alias for --http-auth-header-name")
 	allowUnauthenticatedPing := flag.String("http-allow-unauthenticated-ping", "", "Allow unauthenticated ping: true or false (overrides NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_PING env var)")
 	neo4jHTTPAllowUnauthenticatedPing := flag.String("neo4j-http-allow-unauthenticated-ping", "", "Deprecated alias for --http-allow-unauthenticated-ping")
 	allowUnauthenticatedToolsList := flag.String("http-allow-unauthenticated-tools-list", "", "Allow unauthenticated tools/list: true or false (overrides NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_TOOLS_LIST env var)")
 	neo4jHTTPAllowUnauthenticatedToolsList := flag.String("neo4j-http-allow-unauthenticated-tools-list", "", "Deprecated alias for --http-allow-unauthenticated-tools-list")
+	schemaRichOutput := flag.String("schema-rich-output", "", "Include indexes, constraints and counts in get-schema by default: true or false (overrides NEO4J_MCP_SCHEMA_RICH_OUTPUT env var)")
+	schemaCacheTTL := flag.String("schema-cache-ttl", "", "How long get-schema results are cached, e.g. 10m; 0 disables the cache (overrides NEO4J_MCP_SCHEMA_CACHE_TTL env var)")
+	maxResultRows := flag.String("max-result-rows", "", "Maximum number of rows returned by the query tools; 0 for no limit (overrides NEO4J_MCP_MAX_RESULT_ROWS env var)")
+	writeConfirmationThreshold := flag.String("write-confirmation-threshold", "", "Number of changes above which write-cypher asks the user to confirm; 0 disables it (overrides NEO4J_MCP_WRITE_CONFIRMATION_THRESHOLD env var)")
+	writeConfirmationFallback := flag.String("write-confirmation-fallback", "", "allow or deny the writes above the threshold when the client cannot confirm them (overrides NEO4J_MCP_WRITE_CONFIRMATION_FALLBACK env var)")
+	capabilityRefreshInterval := flag.String("capability-refresh-interval", "", "How often GDS, APOC, vector index support and the edition are probed again, e.g. 1m; 0 probes them once (overrides NEO4J_MCP_CAPABILITY_REFRESH_INTERVAL env var)")
+	schemaWatchInterval := flag.String("schema-watch-interval", "", "How often the schema is checked for the resource subscriptions, e.g. 1m; 0 disables the subscriptions (overrides NEO4J_MCP_SCHEMA_WATCH_INTERVAL env var)")
+	allowedDatabases := flag.String("allowed-databases", "", "Comma-separated list of additional databases tools may target, '*' for all (overrides NEO4J_MCP_ALLOWED_DATABASES env var)")
 
 	flag.Parse()
 
@@ -202,9 +257,20 @@ func ParseConfigFlags() *Args {
 		HTTPTLSEnabled:                    mergeFlagValue(httpTLSEnabled, neo4jHTTPTLSEnabled, "--http-tls-enabled", "--neo4j-http-tls-enabled"),
 		HTTPTLSCertFile:                   mergeFlagValue(httpTLSCertFile, neo4jHTTPTLSCertFile, "--http-tls-cert-file", "--neo4j-http-tls-cert-file"),
 		HTTPTLSKeyFile:                    mergeFlagValue(httpTLSKeyFile, neo4jHTTPTLSKeyFile, "--http-tls-key-file", "--neo4j-http-tls-key-file"),
+		HTTPStateful:                      *httpStateful,
+		HTTPSessionIdleTimeout:            *httpSessionIdleTimeout,
+		HTTPMaxSessions:                   *httpMaxSessions,
 		HTTPAllowUnauthenticatedPing:      mergeFlagValue(allowUnauthenticatedPing, neo4jHTTPAllowUnauthenticatedPing, "--http-allow-unauthenticated-ping", "--neo4j-http-allow-unauthenticated-ping"),
 		HTTPAllowUnauthenticatedToolsList: mergeFlagValue(allowUnauthenticatedToolsList, neo4jHTTPAllowUnauthenticatedToolsList, "--http-allow-unauthenticated-tools-list", "--neo4j-http-allow-unauthenticated-tools-list"),
 		AuthHeaderName:                    mergeFlagValue(authHeaderName, neo4jAuthHeaderName, "--http-auth-header-name", "--neo4j-http-auth-header-name"),
+		AllowedDatabases:                  *allowedDatabases,
+		SchemaRichOutput:                  *schemaRichOutput,
+		SchemaCacheTTL:                    *schemaCacheTTL,
+		MaxResultRows:                     *maxResultRows,
+		WriteConfirmationThreshold:        *writeConfirmationThreshold,
+		WriteConfirmationFallback:         *writeConfirmationFallback,
+		CapabilityRefreshInterval:         *capabilityRefreshInterval,
+		SchemaWatchInterval:               *schemaWatchInterval,
 	}
 }
 
@@ -488,6 +488,54 @@ func TestHandleArgs_CanonicalFlags(t *testing.T) {
 			version:          testVersion,
 			expectedExitCode: -1,
 		},
+		{
+			name:             "allowed databases",
+			args:             []string{testProgramName, "--allowed-databases", "movies,sales"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "schema rich output",
+			args:             []string{testProgramName, "--schema-rich-output", "true"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "schema cache ttl",
+			args:             []string{testProgramName, "--schema-cache-ttl", "10m"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "max result rows",
+			args:             []string{testProgramName, "--max-result-rows", "500"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "write confirmation",
+			args:             []string{testProgramName, "--write-confirmation-threshold", "50", "--write-confirmation-fallback", "deny"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "capability refresh interval",
+			args:             []string{testProgramName, "--capability-refresh-interval", "1m"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "schema watch interval",
+			args:             []string{testProgramName, "--schema-watch-interval", "1m"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
+		{
+			name:             "stateful http sessions",
+			args:             []string{testProgramName, "--http-stateful", "true", "--http-session-idle-timeout", "1h", "--http-max-sessions", "100"},
+			version:          testVersion,
+			expectedExitCode: -1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,212 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+// Package completions completes the prompt and resource template arguments naming labels,
+// relationship types, property keys and databases with the tokens of the database.
+package completions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const (
+	// maxValues is the maximum number of completion values the MCP specification allows in a response
+	maxValues = 100
+	// cacheTTL is how long the tokens are kept, completions are requested on every keystroke
+	cacheTTL = 30 * time.Second
+
+	labelsQuery            = "CALL db.labels() YIELD label RETURN label ORDER BY label"
+	relationshipTypesQuery = "CALL db.relationshipTypes() YIELD relationshipType RETURN relationshipType ORDER BY relationshipType"
+	propertyKeysQuery      = "CALL db.propertyKeys() YIELD propertyKey RETURN propertyKey ORDER BY propertyKey"
+	// databasesQuery runs against the system database, like the list-databases tool
+	databasesQuery = "SHOW DATABASES YIELD name, type WHERE type <> 'system' RETURN DISTINCT name ORDER BY name"
+	systemDatabase = "system"
+)
+
+// tokenKind is the kind of value an argument names.
+type tokenKind string
+
+const (
+	labelToken            tokenKind = "label"
+	relationshipTypeToken tokenKind = "relationshipType"
+	propertyKeyToken      tokenKind = "propertyKey"
+	databaseToken         tokenKind = "database"
+)
+
+var tokenQueries = map[tokenKind]string{
+	labelToken:            labelsQuery,
+	relationshipTypeToken: relationshipTypesQuery,
+	propertyKeyToken:      propertyKeysQuery,
+	databaseToken:         databasesQuery,
+}
+
+// Provider implements the prompt and resource completion providers of the MCP server.
+// The tokens are cached per principal, as the tokens visible to a user depend on its privileges.
+type Provider struct {
+	deps    *tools.ToolDependencies
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+	now     func() time.Time // replaced in tests
+}
+
+type cacheKey struct {
+	principal string
+	database  string // Empty for the default database
+	kind      tokenKind
+}
+
+type cacheEntry struct {
+	values   []string
+	loadedAt time.Time
+}
+
+// NewProvider returns a completion provider reading the tokens with the database service of deps.
+func NewProvider(deps *tools.ToolDependencies) *Provider {
+	return &Provider{
+		deps:    deps,
+		entries: make(map[cacheKey]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// CompletePromptArgument completes an argument of a prompt, the database argument of the prompt selects the database read.
+func (p *Provider) CompletePromptArgument(ctx context.Context, _ string, argument mcp.CompleteArgument, completeContext mcp.CompleteContext) (*mcp.Completion, error) {
+	return p.complete(ctx, argumentKind(argument.Name, false), argument, completeContext.Arguments["database"])
+}
+
+// CompleteResourceArgument completes a variable of a resource template, e.g. the label of neo4j://schema/labels/{label}.
+func (p *Provider) CompleteResourceArgument(ctx context.Context, _ string, argument mcp.CompleteArgument, _ mcp.CompleteContext) (*mcp.Completion, error) {
+	return p.complete(ctx, argumentKind(argument.Name, true), argument, "")
+}
+
+// argumentKind returns the kind of token an argument names, or an empty kind for arguments not completed.
+// The type variable of the resource templates is a relationship type.
+func argumentKind(name string, resourceTemplate bool) tokenKind {
+	switch strings.TrimSuffix(strings.ToLower(name), "s") {
+	case "label", "neighborsof":
+		return labelToken
+	case "relationshiptype", "reltype":
+		return relationshipTypeToken
+	case "type":
+		if resourceTemplate {
+			return relationshipTypeToken
+		}
+	case "propertykey", "property":
+		return propertyKeyToken
+	case "database":
+		return databaseToken
+	}
+	return ""
+}
+
+func (p *Provider) complete(ctx context.Context, kind tokenKind, argument mcp.CompleteArgument, targetDatabase string) (*mcp.Completion, error) {
+	if kind == "" {
+		return &mcp.Completion{Values: []string{}}, nil
+	}
+	if p.deps.DBService == nil {
+		return nil, errors.New("database service is not initialized")
+	}
+	if kind == databaseToken {
+		targetDatabase = systemDatabase
+	}
+	if targetDatabase != "" && targetDatabase != systemDatabase && !config.IsDatabaseAllowed(p.deps.AllowedDatabases, targetDatabase) {
+		// Completing the tokens of a database not allowed would disclose them
+		return &mcp.Completion{Values: []string{}}, nil
+	}
+
+	tokens, err := p.tokens(ctx, kind, targetDatabase)
+	if err != nil {
+		slog.Error("failed to complete argument", "argument", argument.Name, "error", err)
+		return nil, err
+	}
+	if kind == databaseToken {
+		tokens = slices.DeleteFunc(slices.Clone(tokens), func(name string) bool {
+			return !config.IsDatabaseAllowed(p.deps.AllowedDatabases, name)
+		})
+	}
+
+	// Plural arguments are comma separated lists, only their last item is completed
+	head, prefix := "", argument.Value
+	if strings.HasSuffix(argument.Name, "s") {
+		if index := strings.LastIndex(argument.Value, ","); index >= 0 {
+			head, prefix = argument.Value[:index+1], argument.Value[index+1:]
+		}
+	}
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+
+	values := make([]string, 0, min(len(tokens), maxValues))
+	total := 0
+	for _, token := range tokens {
+		if !strings.HasPrefix(strings.ToLower(token), prefix) {
+			continue
+		}
+		total++
+		if len(values) < maxValues {
+			values = append(values, head+token)
+		}
+	}
+	return &mcp.Completion{Values: values, Total: total, HasMore: total > len(values)}, nil
+}
+
+// tokens returns the tokens of a kind, from the cache when they were read recently.
+func (p *Provider) tokens(ctx context.Context, kind tokenKind, targetDatabase string) ([]string, error) {
+	if targetDatabase == "" {
+		// The transport may select the database, e.g. the X-Neo4j-Database HTTP header
+		targetDatabase, _ = database.GetTargetDatabase(ctx)
+	}
+	key := cacheKey{principal: auth.Principal(ctx), database: targetDatabase, kind: kind}
+
+	p.mu.Lock()
+	p.removeExpired()
+	entry, found := p.entries[key]
+	p.mu.Unlock()
+	if found {
+		return entry.values, nil
+	}
+
+	if targetDatabase != "" {
+		ctx = database.WithTargetDatabase(ctx, targetDatabase)
+	}
+	records, err := p.deps.DBService.ExecuteReadQuery(ctx, tokenQueries[kind], nil)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		if len(record.Values) != 1 {
+			return nil, fmt.Errorf("invalid %s returned", kind)
+		}
+		if value, ok := record.Values[0].(string); ok {
+			values = append(values, value)
+		}
+	}
+
+	p.mu.Lock()
+	p.entries[key] = cacheEntry{values: values, loadedAt: p.now()}
+	p.mu.Unlock()
+	return values, nil
+}
+
+// removeExpired drops the expired entries, so the principals of rotated credentials do not stay cached.
+// The caller must hold p.mu.
+func (p *Provider) removeExpired() {
+	now := p.now()
+	for key, entry := range p.entries {
+		if now.Sub(entry.loadedAt) >= cacheTTL {
+			delete(p.entries, key)
+		}
+	}
+}
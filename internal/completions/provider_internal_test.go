@@ -0,0 +1,46 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package completions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neo4j/mcp/internal/auth"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProviderRemovesExpiredEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().
+		ExecuteReadQuery(gomock.Any(), labelsQuery, gomock.Nil()).
+		Return([]*neo4j.Record{{Keys: []string{"label"}, Values: []any{"Movie"}}}, nil).
+		Times(2)
+
+	now := time.Now()
+	provider := NewProvider(&tools.ToolDependencies{DBService: mockDB})
+	provider.now = func() time.Time { return now }
+
+	if _, err := provider.tokens(auth.WithBearerToken(context.Background(), "old-token"), labelToken, ""); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	now = now.Add(cacheTTL)
+	if _, err := provider.tokens(auth.WithBearerToken(context.Background(), "rotated-token"), labelToken, ""); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(provider.entries) != 1 {
+		t.Errorf("Expected only the entry of the rotated token to be cached, got %d entries", len(provider.entries))
+	}
+	if _, found := provider.entries[cacheKey{principal: auth.Principal(auth.WithBearerToken(context.Background(), "old-token")), kind: labelToken}]; found {
+		t.Error("Expected the expired entry of the old token to be removed")
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package completions_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/completions"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func tokenRecords(tokens ...string) []*neo4j.Record {
+	records := make([]*neo4j.Record, 0, len(tokens))
+	for _, token := range tokens {
+		records = append(records, &neo4j.Record{Keys: []string{"token"}, Values: []any{token}})
+	}
+	return records
+}
+
+// targetsDatabase returns a gomock condition matching a context that targets the given database.
+func targetsDatabase(name string) func(any) bool {
+	return func(ctx any) bool {
+		c, ok := ctx.(context.Context)
+		if !ok {
+			return false
+		}
+		target, ok := database.GetTargetDatabase(c)
+		return ok && target == name
+	}
+}
+
+func TestProvider(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("completes labels by prefix", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), "CALL db.labels() YIELD label RETURN label ORDER BY label", gomock.Nil()).
+			Return(tokenRecords("Genre", "Movie", "MovieStar", "Person"), nil)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB})
+		completion, err := provider.CompleteResourceArgument(context.Background(), "neo4j://schema/labels/{label}",
+			mcp.CompleteArgument{Name: "label", Value: "mov"}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if expected := []string{"Movie", "MovieStar"}; !slices.Equal(completion.Values, expected) {
+			t.Errorf("Expected %v, got %v", expected, completion.Values)
+		}
+		if completion.Total != 2 || completion.HasMore {
+			t.Errorf("Expected a total of 2 without more, got %d and %t", completion.Total, completion.HasMore)
+		}
+	})
+
+	t.Run("completes the relationship type of the resource template", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), "CALL db.relationshipTypes() YIELD relationshipType RETURN relationshipType ORDER BY relationshipType", gomock.Nil()).
+			Return(tokenRecords("ACTED_IN", "DIRECTED"), nil)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB})
+		completion, err := provider.CompleteResourceArgument(context.Background(), "neo4j://schema/relationships/{type}",
+			mcp.CompleteArgument{Name: "type", Value: "D"}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if expected := []string{"DIRECTED"}; !slices.Equal(completion.Values, expected) {
+			t.Errorf("Expected %v, got %v", expected, completion.Values)
+		}
+	})
+
+	t.Run("completes the last item of a list argument", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(tokenRecords("Genre", "Movie", "Person"), nil)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB})
+		completion, err := provider.CompletePromptArgument(context.Background(), "write-cypher-query",
+			mcp.CompleteArgument{Name: "labels", Value: "Person,Mo"}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if expected := []string{"Person,Movie"}; !slices.Equal(completion.Values, expected) {
+			t.Errorf("Expected %v, got %v", expected, completion.Values)
+		}
+	})
+
+	t.Run("caches the tokens per principal", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(tokenRecords("name", "title"), nil).
+			Times(2)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB})
+		alice := auth.WithBearerToken(context.Background(), "alice-token")
+		bob := auth.WithBearerToken(context.Background(), "bob-token")
+		for _, ctx := range []context.Context{alice, alice, bob, bob} {
+			if _, err := provider.CompletePromptArgument(ctx, "data-quality-review", mcp.CompleteArgument{Name: "propertyKey", Value: "t"}, mcp.CompleteContext{}); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+		}
+	})
+
+	t.Run("completes the allowed databases", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Cond(targetsDatabase("system")), gomock.Any(), gomock.Nil()).
+			Return(tokenRecords("movies", "neo4j", "secrets"), nil)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB, AllowedDatabases: []string{"neo4j", "movies"}})
+		completion, err := provider.CompletePromptArgument(context.Background(), "explore-graph",
+			mcp.CompleteArgument{Name: "database", Value: ""}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if expected := []string{"movies", "neo4j"}; !slices.Equal(completion.Values, expected) {
+			t.Errorf("Expected %v, got %v", expected, completion.Values)
+		}
+	})
+
+	t.Run("reads the tokens of the database argument", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Cond(targetsDatabase("movies")), gomock.Any(), gomock.Nil()).
+			Return(tokenRecords("Movie"), nil)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB, AllowedDatabases: []string{"neo4j", "movies"}})
+		completion, err := provider.CompletePromptArgument(context.Background(), "explore-graph",
+			mcp.CompleteArgument{Name: "label", Value: ""}, mcp.CompleteContext{Arguments: map[string]string{"database": "movies"}})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if expected := []string{"Movie"}; !slices.Equal(completion.Values, expected) {
+			t.Errorf("Expected %v, got %v", expected, completion.Values)
+		}
+	})
+
+	t.Run("does not complete the tokens of a database not allowed", func(t *testing.T) {
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: db.NewMockService(ctrl), AllowedDatabases: []string{"neo4j"}})
+		completion, err := provider.CompletePromptArgument(context.Background(), "explore-graph",
+			mcp.CompleteArgument{Name: "label", Value: ""}, mcp.CompleteContext{Arguments: map[string]string{"database": "secrets"}})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(completion.Values) != 0 {
+			t.Errorf("Expected no values, got %v", completion.Values)
+		}
+	})
+
+	t.Run("does not complete other arguments", func(t *testing.T) {
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: db.NewMockService(ctrl)})
+		completion, err := provider.CompletePromptArgument(context.Background(), "write-cypher-query",
+			mcp.CompleteArgument{Name: "task", Value: "Find"}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(completion.Values) != 0 {
+			t.Errorf("Expected no values, got %v", completion.Values)
+		}
+	})
+
+	t.Run("caps the number of values", func(t *testing.T) {
+		labels := make([]string, 0, 150)
+		for i := range 150 {
+			labels = append(labels, fmt.Sprintf("Label%03d", i))
+		}
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(tokenRecords(labels...), nil)
+
+		provider := completions.NewProvider(&tools.ToolDependencies{DBService: mockDB})
+		completion, err := provider.CompletePromptArgument(context.Background(), "explore-graph",
+			mcp.CompleteArgument{Name: "label", Value: "label"}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(completion.Values) != 100 || completion.Total != 150 || !completion.HasMore {
+			t.Errorf("Expected 100 of 150 values, got %d of %d", len(completion.Values), completion.Total)
+		}
+	})
+}
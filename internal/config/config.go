@@ -11,6 +11,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type TransportMode string
@@ -20,11 +21,37 @@ const (
 	DefaultSchemaSampleSize   int32         = 100
 	TransportModeStdio        TransportMode = "stdio"
 	TransportModeHTTP         TransportMode = "http"
+	TransportModeSSE          TransportMode = "sse" // legacy HTTP+SSE transport, for the clients without streamable HTTP
 	DeprecatedVariableMessage string        = "Warning: deprecated %s %q; use %q instead. Support will be removed in v2.\n"
 )
 
 // ValidTransportModes defines the allowed transport mode values
-var ValidTransportModes = []TransportMode{TransportModeStdio, TransportModeHTTP}
+var ValidTransportModes = []TransportMode{TransportModeStdio, TransportModeHTTP, TransportModeSSE}
+
+// IsHTTP reports whether the transport mode is served over HTTP, http or sse,
+// where the credentials are provided per-request and the HTTP settings apply.
+func (m TransportMode) IsHTTP() bool {
+	return m == TransportModeHTTP || m == TransportModeSSE
+}
+
+const (
+	// DefaultWriteConfirmationThreshold is the default number of changes write-cypher runs without asking the user
+	DefaultWriteConfirmationThreshold int32 = 100
+	// DefaultCapabilityRefreshInterval is how often the optional features of the Neo4j instance are probed again
+	DefaultCapabilityRefreshInterval = 5 * time.Minute
+	// DefaultSchemaWatchInterval is how often the schema is fingerprinted for the resource subscriptions
+	DefaultSchemaWatchInterval = 30 * time.Second
+	// DefaultHTTPSessionIdleTimeout is how long an unused stateful HTTP session is kept
+	DefaultHTTPSessionIdleTimeout = 30 * time.Minute
+	// DefaultHTTPMaxSessions is the default number of stateful HTTP sessions kept at once
+	DefaultHTTPMaxSessions int32 = 1000
+	// Write confirmation fallbacks, applied when the client cannot ask the user to confirm a write query
+	WriteConfirmationFallbackAllow = "allow"
+	WriteConfirmationFallbackDeny  = "deny"
+)
+
+// ValidWriteConfirmationFallbacks lists the allowed write confirmation fallback values
+var ValidWriteConfirmationFallbacks = []string{WriteConfirmationFallbackAllow, WriteConfirmationFallbackDeny}
 
 // Config holds the application configuration
 type Config struct {
@@ -37,16 +64,48 @@ type Config struct {
 	LogLevel                      string
 	LogFormat                     string
 	SchemaSampleSize              int32
+	SchemaRichOutput              bool          // If true, get-schema returns indexes, constraints and counts unless the call asks otherwise
+	SchemaCacheTTL                time.Duration // How long get-schema results are cached, 0 disables the cache
+	MaxResultRows                 int32         // Maximum number of rows returned by the query tools, 0 for no limit
+	WriteConfirmationThreshold    int32         // Number of changes above which write-cypher asks the user to confirm, 0 disables the confirmation
+	WriteConfirmationFallback     string        // "allow" or "deny" the writes above the threshold when the client cannot confirm them
+	CapabilityRefreshInterval     time.Duration // How often GDS, APOC, vector index support and the edition are probed again, 0 probes them once
+	SchemaWatchInterval           time.Duration // How often the schema is fingerprinted to notify the resource subscribers, 0 disables the subscriptions
 	TransportMode                 TransportMode // MCP Transport mode (e.g., "stdio", "http")
 	HTTPPort                      string        // HTTP server port (default: "443" with TLS, "80" without TLS)
 	HTTPHost                      string        // HTTP server host (default: "127.0.0.1")
 	HTTPAllowedOrigins            string        // Comma-separated list of allowed CORS origins (optional, "*" for all)
 	HTTPTLSEnabled                bool          // If true, enables TLS/HTTPS for HTTP server (default: false)
+	HTTPStateful                  bool          // If true, the HTTP server issues Mcp-Session-Id and keeps per-session settings (default: false, stateless)
+	HTTPSessionIdleTimeout        time.Duration // How long an unused stateful HTTP session is kept, 0 keeps it until deleted
+	HTTPMaxSessions               int32         // Maximum number of stateful HTTP sessions, no session is created beyond it
 	HTTPTLSCertFile               string        // Path to TLS certificate file (required if HTTPTLSEnabled is true)
 	HTTPTLSKeyFile                string        // Path to TLS private key file (required if HTTPTLSEnabled is true)
 	AuthHeaderName                string        // HTTP header name to read auth credentials from (default: "Authorization")
 	AllowUnauthenticatedPing      bool          // If true, allows unauthenticated ping health checks in HTTP mode
 	AllowUnauthenticatedToolsList bool          // If true, allows unauthenticated tools list in HTTP mode
+	AllowedDatabases              []string      // Databases that may be targeted in addition to Database ("*" for all)
+}
+
+// TargetableDatabases returns the databases that tools and HTTP clients may target:
+// the configured default database followed by the allowlist.
+func (c *Config) TargetableDatabases() []string {
+	databases := make([]string, 0, len(c.AllowedDatabases)+1)
+	if c.Database != "" {
+		databases = append(databases, c.Database)
+	}
+	return append(databases, c.AllowedDatabases...)
+}
+
+// IsDatabaseAllowed reports whether the database name is part of the allowed list.
+// Database names are case-insensitive in Neo4j; a "*" entry allows any database.
+func IsDatabaseAllowed(allowed []string, name string) bool {
+	for _, database := range allowed {
+		if database == "*" || strings.EqualFold(database, name) {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate validates the configuration and returns an error if invalid
@@ -71,7 +130,7 @@ func (c *Config) Validate() error {
 	}
 
 	// For STDIO mode, require username and password from environment
-	// For HTTP mode, credentials come from per-request Basic Auth headers
+	// For HTTP and SSE mode, credentials come from per-request Basic Auth headers
 	if c.TransportMode == TransportModeStdio {
 		if c.Username == "" {
 			return fmt.Errorf("Neo4j username is required for STDIO mode")
@@ -83,8 +142,8 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("Neo4j username and password should not be set for HTTP transport mode; credentials are provided per-request via Basic Auth headers")
 	}
 
-	// For HTTP mode with TLS enabled, require certificate and key files
-	if c.TransportMode == TransportModeHTTP && c.HTTPTLSEnabled {
+	// For HTTP and SSE mode with TLS enabled, require certificate and key files
+	if c.TransportMode.IsHTTP() && c.HTTPTLSEnabled {
 		if c.HTTPTLSCertFile == "" {
 			return fmt.Errorf("TLS certificate file is required when TLS is enabled (set NEO4J_MCP_HTTP_TLS_CERT_FILE)")
 		}
@@ -99,6 +158,25 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.TransportMode == TransportModeHTTP && c.HTTPStateful && c.HTTPMaxSessions <= 0 {
+		return fmt.Errorf("invalid max HTTP sessions %d, must be a positive integer", c.HTTPMaxSessions)
+	}
+
+	if c.MaxResultRows < 0 {
+		return fmt.Errorf("invalid max result rows %d, must be 0 (no limit) or a positive integer", c.MaxResultRows)
+	}
+
+	if c.WriteConfirmationThreshold < 0 {
+		return fmt.Errorf("invalid write confirmation threshold %d, must be 0 (no confirmation) or a positive integer", c.WriteConfirmationThreshold)
+	}
+	// Default to allow if not provided, like the transport mode
+	if c.WriteConfirmationFallback == "" {
+		c.WriteConfirmationFallback = WriteConfirmationFallbackAllow
+	}
+	if !slices.Contains(ValidWriteConfirmationFallbacks, c.WriteConfirmationFallback) {
+		return fmt.Errorf("invalid write confirmation fallback '%s', must be one of %v", c.WriteConfirmationFallback, ValidWriteConfirmationFallbacks)
+	}
+
 	return nil
 }
 
@@ -115,11 +193,22 @@ type CLIOverrides struct {
 	Host                          string
 	AllowedOrigins                string
 	TLSEnabled                    string
+	Stateful                      string
+	SessionIdleTimeout            string
+	MaxSessions                   string
 	TLSCertFile                   string
 	TLSKeyFile                    string
 	AuthHeaderName                string
 	AllowUnauthenticatedPing      string
 	AllowUnauthenticatedToolsList string
+	AllowedDatabases              string
+	SchemaRichOutput              string
+	SchemaCacheTTL                string
+	MaxResultRows                 string
+	WriteConfirmationThreshold    string
+	WriteConfirmationFallback     string
+	CapabilityRefreshInterval     string
+	SchemaWatchInterval           string
 }
 
 // LoadConfig loads configuration from environment variables, applies CLI overrides, and validates.
@@ -143,11 +232,22 @@ func LoadConfig(cliOverrides *CLIOverrides) (*Config, error) {
 		HTTPHost:                      GetEnvWithDefault("NEO4J_MCP_HTTP_HOST", "127.0.0.1"),
 		HTTPAllowedOrigins:            GetEnv("NEO4J_MCP_HTTP_ALLOWED_ORIGINS"),
 		HTTPTLSEnabled:                ParseBool(GetEnv("NEO4J_MCP_HTTP_TLS_ENABLED"), false),
+		HTTPStateful:                  ParseBool(GetEnv("NEO4J_MCP_HTTP_STATEFUL"), false),
+		HTTPSessionIdleTimeout:        ParseDuration(GetEnv("NEO4J_MCP_HTTP_SESSION_IDLE_TIMEOUT"), DefaultHTTPSessionIdleTimeout),
+		HTTPMaxSessions:               ParseInt32(GetEnv("NEO4J_MCP_HTTP_MAX_SESSIONS"), DefaultHTTPMaxSessions),
 		HTTPTLSCertFile:               GetEnv("NEO4J_MCP_HTTP_TLS_CERT_FILE"),
 		HTTPTLSKeyFile:                GetEnv("NEO4J_MCP_HTTP_TLS_KEY_FILE"),
 		AuthHeaderName:                GetEnvWithAliasesDefault("NEO4J_MCP_HTTP_AUTH_HEADER_NAME", "Authorization", "NEO4J_HTTP_AUTH_HEADER_NAME"),
 		AllowUnauthenticatedPing:      ParseBool(GetEnvWithAliases("NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_PING", "NEO4J_HTTP_ALLOW_UNAUTHENTICATED_PING"), false),
 		AllowUnauthenticatedToolsList: ParseBool(GetEnvWithAliases("NEO4J_MCP_HTTP_ALLOW_UNAUTHENTICATED_TOOLS_LIST", "NEO4J_HTTP_ALLOW_UNAUTHENTICATED_TOOLS_LIST"), false),
+		AllowedDatabases:              ParseList(GetEnv("NEO4J_MCP_ALLOWED_DATABASES")),
+		SchemaRichOutput:              ParseBool(GetEnv("NEO4J_MCP_SCHEMA_RICH_OUTPUT"), false),
+		SchemaCacheTTL:                ParseDuration(GetEnv("NEO4J_MCP_SCHEMA_CACHE_TTL"), 0),
+		MaxResultRows:                 ParseInt32(GetEnv("NEO4J_MCP_MAX_RESULT_ROWS"), 0),
+		WriteConfirmationThreshold:    ParseInt32(GetEnv("NEO4J_MCP_WRITE_CONFIRMATION_THRESHOLD"), DefaultWriteConfirmationThreshold),
+		WriteConfirmationFallback:     strings.ToLower(GetEnvWithDefault("NEO4J_MCP_WRITE_CONFIRMATION_FALLBACK", WriteConfirmationFallbackAllow)),
+		CapabilityRefreshInterval:     ParseDuration(GetEnv("NEO4J_MCP_CAPABILITY_REFRESH_INTERVAL"), DefaultCapabilityRefreshInterval),
+		SchemaWatchInterval:           ParseDuration(GetEnv("NEO4J_MCP_SCHEMA_WATCH_INTERVAL"), DefaultSchemaWatchInterval),
 	}
 
 	// Apply CLI overrides if provided
@@ -185,6 +285,15 @@ func LoadConfig(cliOverrides *CLIOverrides) (*Config, error) {
 		if cliOverrides.TLSEnabled != "" {
 			cfg.HTTPTLSEnabled = ParseBool(cliOverrides.TLSEnabled, false)
 		}
+		if cliOverrides.Stateful != "" {
+			cfg.HTTPStateful = ParseBool(cliOverrides.Stateful, false)
+		}
+		if cliOverrides.SessionIdleTimeout != "" {
+			cfg.HTTPSessionIdleTimeout = ParseDuration(cliOverrides.SessionIdleTimeout, DefaultHTTPSessionIdleTimeout)
+		}
+		if cliOverrides.MaxSessions != "" {
+			cfg.HTTPMaxSessions = ParseInt32(cliOverrides.MaxSessions, DefaultHTTPMaxSessions)
+		}
 		if cliOverrides.TLSCertFile != "" {
 			cfg.HTTPTLSCertFile = cliOverrides.TLSCertFile
 		}
@@ -200,6 +309,30 @@ func LoadConfig(cliOverrides *CLIOverrides) (*Config, error) {
 		if cliOverrides.AllowUnauthenticatedToolsList != "" {
 			cfg.AllowUnauthenticatedToolsList = ParseBool(cliOverrides.AllowUnauthenticatedToolsList, false)
 		}
+		if cliOverrides.AllowedDatabases != "" {
+			cfg.AllowedDatabases = ParseList(cliOverrides.AllowedDatabases)
+		}
+		if cliOverrides.SchemaRichOutput != "" {
+			cfg.SchemaRichOutput = ParseBool(cliOverrides.SchemaRichOutput, false)
+		}
+		if cliOverrides.SchemaCacheTTL != "" {
+			cfg.SchemaCacheTTL = ParseDuration(cliOverrides.SchemaCacheTTL, 0)
+		}
+		if cliOverrides.MaxResultRows != "" {
+			cfg.MaxResultRows = ParseInt32(cliOverrides.MaxResultRows, 0)
+		}
+		if cliOverrides.WriteConfirmationThreshold != "" {
+			cfg.WriteConfirmationThreshold = ParseInt32(cliOverrides.WriteConfirmationThreshold, DefaultWriteConfirmationThreshold)
+		}
+		if cliOverrides.WriteConfirmationFallback != "" {
+			cfg.WriteConfirmationFallback = strings.ToLower(cliOverrides.WriteConfirmationFallback)
+		}
+		if cliOverrides.CapabilityRefreshInterval != "" {
+			cfg.CapabilityRefreshInterval = ParseDuration(cliOverrides.CapabilityRefreshInterval, DefaultCapabilityRefreshInterval)
+		}
+		if cliOverrides.SchemaWatchInterval != "" {
+			cfg.SchemaWatchInterval = ParseDuration(cliOverrides.SchemaWatchInterval, DefaultSchemaWatchInterval)
+		}
 	}
 
 	// Set default HTTP port based on TLS configuration if not explicitly provided
@@ -318,6 +451,22 @@ func ParseBool(value string, defaultValue bool) bool {
 	return parsed
 }
 
+// ParseList parses a comma-separated string into a slice of trimmed, non-empty values.
+// Returns nil if the string is empty.
+func ParseList(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	items := strings.Split(value, ",")
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
 // ParseInt32 parses a string to int32.
 // Returns the default value if the string is empty or invalid.
 func ParseInt32(value string, defaultValue int32) int32 {
@@ -331,3 +480,17 @@ func ParseInt32(value string, defaultValue int32) int32 {
 	}
 	return int32(parsed)
 }
+
+// ParseDuration parses a duration such as "30s" or "10m".
+// Returns the default value if the string is empty, invalid or negative.
+func ParseDuration(value string, defaultValue time.Duration) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		log.Printf("Warning: Invalid duration value %q, using default: %v", value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
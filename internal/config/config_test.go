@@ -6,8 +6,10 @@ package config
 import (
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/neo4j/mcp/internal/testutil"
 )
@@ -98,6 +100,27 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "Neo4j username and password should not be set for HTTP transport mode; credentials are provided per-request via Basic Auth headers",
 		},
+		{
+			name: "SSE mode without credentials",
+			cfg: &Config{
+				URI:           "bolt://localhost:7687",
+				Database:      "neo4j",
+				TransportMode: TransportModeSSE,
+			},
+			wantErr: false,
+		},
+		{
+			name: "credentials set for SSE mode should raise error",
+			cfg: &Config{
+				URI:           "bolt://localhost:7687",
+				Username:      "neo4j",
+				Password:      "password",
+				Database:      "neo4j",
+				TransportMode: TransportModeSSE,
+			},
+			wantErr: true,
+			errMsg:  "Neo4j username and password should not be set for HTTP transport mode",
+		},
 	}
 
 	for _, tt := range tests {
@@ -571,6 +594,18 @@ func TestConfig_Validate_TLS(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "SSE mode with TLS enabled but missing key file",
+			cfg: &Config{
+				URI:             "bolt://localhost:7687",
+				TransportMode:   TransportModeSSE,
+				HTTPTLSEnabled:  true,
+				HTTPTLSCertFile: certPath,
+				HTTPTLSKeyFile:  "",
+			},
+			wantErr: true,
+			errMsg:  "TLS key file is required when TLS is enabled",
+		},
 		{
 			name: "STDIO mode with TLS enabled (should be ignored)",
 			cfg: &Config{
@@ -981,3 +1016,397 @@ func TestLoadConfig_AuthHeaderName(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadConfig_AllowedDatabases(t *testing.T) {
+	t.Run("defaults to no additional database", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+		t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+		t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+		t.Setenv("NEO4J_MCP_PASSWORD", "password")
+		t.Setenv("NEO4J_MCP_DATABASE", "neo4j")
+		t.Setenv("NEO4J_MCP_ALLOWED_DATABASES", "")
+
+		cfg, err := LoadConfig(nil)
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error: %v", err)
+		}
+		if len(cfg.AllowedDatabases) != 0 {
+			t.Errorf("LoadConfig() AllowedDatabases = %v, want empty", cfg.AllowedDatabases)
+		}
+		if !slices.Equal(cfg.TargetableDatabases(), []string{"neo4j"}) {
+			t.Errorf("TargetableDatabases() = %v, want [neo4j]", cfg.TargetableDatabases())
+		}
+	})
+
+	t.Run("parses the environment variable", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+		t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+		t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+		t.Setenv("NEO4J_MCP_PASSWORD", "password")
+		t.Setenv("NEO4J_MCP_DATABASE", "neo4j")
+		t.Setenv("NEO4J_MCP_ALLOWED_DATABASES", " movies, sales ,,")
+
+		cfg, err := LoadConfig(nil)
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error: %v", err)
+		}
+		if !slices.Equal(cfg.AllowedDatabases, []string{"movies", "sales"}) {
+			t.Errorf("LoadConfig() AllowedDatabases = %v, want [movies sales]", cfg.AllowedDatabases)
+		}
+		if !slices.Equal(cfg.TargetableDatabases(), []string{"neo4j", "movies", "sales"}) {
+			t.Errorf("TargetableDatabases() = %v, want [neo4j movies sales]", cfg.TargetableDatabases())
+		}
+	})
+
+	t.Run("cli override takes precedence", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+		t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+		t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+		t.Setenv("NEO4J_MCP_PASSWORD", "password")
+		t.Setenv("NEO4J_MCP_ALLOWED_DATABASES", "movies")
+
+		cfg, err := LoadConfig(&CLIOverrides{AllowedDatabases: "*"})
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error: %v", err)
+		}
+		if !slices.Equal(cfg.AllowedDatabases, []string{"*"}) {
+			t.Errorf("LoadConfig() AllowedDatabases = %v, want [*]", cfg.AllowedDatabases)
+		}
+	})
+}
+
+func TestIsDatabaseAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []string
+		database string
+		expected bool
+	}{
+		{name: "listed database", allowed: []string{"neo4j", "movies"}, database: "movies", expected: true},
+		{name: "case-insensitive match", allowed: []string{"neo4j", "movies"}, database: "Movies", expected: true},
+		{name: "unlisted database", allowed: []string{"neo4j", "movies"}, database: "sales", expected: false},
+		{name: "wildcard", allowed: []string{"neo4j", "*"}, database: "sales", expected: true},
+		{name: "empty allowlist", allowed: nil, database: "neo4j", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDatabaseAllowed(tt.allowed, tt.database); got != tt.expected {
+				t.Errorf("IsDatabaseAllowed(%v, %q) = %v, want %v", tt.allowed, tt.database, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_SchemaRichOutput(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+	t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+	t.Setenv("NEO4J_MCP_PASSWORD", "password")
+
+	t.Run("defaults to the compact output", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_SCHEMA_RICH_OUTPUT", "")
+
+		cfg, err := LoadConfig(nil)
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error: %v", err)
+		}
+		if cfg.SchemaRichOutput {
+			t.Error("LoadConfig() SchemaRichOutput = true, want false")
+		}
+	})
+
+	t.Run("value from env", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_SCHEMA_RICH_OUTPUT", "true")
+
+		cfg, err := LoadConfig(nil)
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error: %v", err)
+		}
+		if !cfg.SchemaRichOutput {
+			t.Error("LoadConfig() SchemaRichOutput = false, want true")
+		}
+	})
+
+	t.Run("CLI override takes precedence", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_SCHEMA_RICH_OUTPUT", "true")
+
+		cfg, err := LoadConfig(&CLIOverrides{SchemaRichOutput: "false"})
+		if err != nil {
+			t.Fatalf("LoadConfig() unexpected error: %v", err)
+		}
+		if cfg.SchemaRichOutput {
+			t.Error("LoadConfig() SchemaRichOutput = true, want false")
+		}
+	})
+}
+
+func TestLoadConfig_SchemaCacheTTL(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+	t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+	t.Setenv("NEO4J_MCP_PASSWORD", "password")
+
+	tests := []struct {
+		name     string
+		env      string
+		cli      string
+		expected time.Duration
+	}{
+		{name: "disabled by default", expected: 0},
+		{name: "value from env", env: "10m", expected: 10 * time.Minute},
+		{name: "invalid value from env", env: "ten minutes", expected: 0},
+		{name: "negative value from env", env: "-1m", expected: 0},
+		{name: "CLI override takes precedence", env: "10m", cli: "30s", expected: 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NEO4J_MCP_SCHEMA_CACHE_TTL", tt.env)
+
+			cfg, err := LoadConfig(&CLIOverrides{SchemaCacheTTL: tt.cli})
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error: %v", err)
+			}
+			if cfg.SchemaCacheTTL != tt.expected {
+				t.Errorf("LoadConfig() SchemaCacheTTL = %v, want %v", cfg.SchemaCacheTTL, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_CapabilityRefreshInterval(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+	t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+	t.Setenv("NEO4J_MCP_PASSWORD", "password")
+
+	tests := []struct {
+		name     string
+		env      string
+		cli      string
+		expected time.Duration
+	}{
+		{name: "default interval", expected: DefaultCapabilityRefreshInterval},
+		{name: "value from env", env: "1m", expected: time.Minute},
+		{name: "disabled from env", env: "0", expected: 0},
+		{name: "invalid value from env", env: "often", expected: DefaultCapabilityRefreshInterval},
+		{name: "CLI override takes precedence", env: "1m", cli: "30s", expected: 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NEO4J_MCP_CAPABILITY_REFRESH_INTERVAL", tt.env)
+
+			cfg, err := LoadConfig(&CLIOverrides{CapabilityRefreshInterval: tt.cli})
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error: %v", err)
+			}
+			if cfg.CapabilityRefreshInterval != tt.expected {
+				t.Errorf("LoadConfig() CapabilityRefreshInterval = %v, want %v", cfg.CapabilityRefreshInterval, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_SchemaWatchInterval(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+	t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+	t.Setenv("NEO4J_MCP_PASSWORD", "password")
+
+	tests := []struct {
+		name     string
+		env      string
+		cli      string
+		expected time.Duration
+	}{
+		{name: "default interval", expected: DefaultSchemaWatchInterval},
+		{name: "value from env", env: "1m", expected: time.Minute},
+		{name: "disabled from env", env: "0", expected: 0},
+		{name: "invalid value from env", env: "often", expected: DefaultSchemaWatchInterval},
+		{name: "CLI override takes precedence", env: "1m", cli: "10s", expected: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NEO4J_MCP_SCHEMA_WATCH_INTERVAL", tt.env)
+
+			cfg, err := LoadConfig(&CLIOverrides{SchemaWatchInterval: tt.cli})
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error: %v", err)
+			}
+			if cfg.SchemaWatchInterval != tt.expected {
+				t.Errorf("LoadConfig() SchemaWatchInterval = %v, want %v", cfg.SchemaWatchInterval, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_HTTPSessions(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "http")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+
+	tests := []struct {
+		name                string
+		env                 map[string]string
+		cli                 CLIOverrides
+		expectedStateful    bool
+		expectedIdleTimeout time.Duration
+		expectedMaxSessions int32
+		expectedErr         string
+	}{
+		{
+			name:                "stateless by default",
+			expectedIdleTimeout: DefaultHTTPSessionIdleTimeout,
+			expectedMaxSessions: DefaultHTTPMaxSessions,
+		},
+		{
+			name: "values from env",
+			env: map[string]string{
+				"NEO4J_MCP_HTTP_STATEFUL":             "true",
+				"NEO4J_MCP_HTTP_SESSION_IDLE_TIMEOUT": "1h",
+				"NEO4J_MCP_HTTP_MAX_SESSIONS":         "10",
+			},
+			expectedStateful:    true,
+			expectedIdleTimeout: time.Hour,
+			expectedMaxSessions: 10,
+		},
+		{
+			name:                "CLI override takes precedence",
+			env:                 map[string]string{"NEO4J_MCP_HTTP_STATEFUL": "false", "NEO4J_MCP_HTTP_MAX_SESSIONS": "10"},
+			cli:                 CLIOverrides{Stateful: "true", SessionIdleTimeout: "0", MaxSessions: "20"},
+			expectedStateful:    true,
+			expectedIdleTimeout: 0,
+			expectedMaxSessions: 20,
+		},
+		{
+			name:        "no session allowed",
+			env:         map[string]string{"NEO4J_MCP_HTTP_STATEFUL": "true", "NEO4J_MCP_HTTP_MAX_SESSIONS": "0"},
+			expectedErr: "invalid max HTTP sessions 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"NEO4J_MCP_HTTP_STATEFUL", "NEO4J_MCP_HTTP_SESSION_IDLE_TIMEOUT", "NEO4J_MCP_HTTP_MAX_SESSIONS"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			cfg, err := LoadConfig(&tt.cli)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("LoadConfig() error = %v, want %q", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error: %v", err)
+			}
+			if cfg.HTTPStateful != tt.expectedStateful {
+				t.Errorf("LoadConfig() HTTPStateful = %v, want %v", cfg.HTTPStateful, tt.expectedStateful)
+			}
+			if cfg.HTTPSessionIdleTimeout != tt.expectedIdleTimeout {
+				t.Errorf("LoadConfig() HTTPSessionIdleTimeout = %v, want %v", cfg.HTTPSessionIdleTimeout, tt.expectedIdleTimeout)
+			}
+			if cfg.HTTPMaxSessions != tt.expectedMaxSessions {
+				t.Errorf("LoadConfig() HTTPMaxSessions = %v, want %v", cfg.HTTPMaxSessions, tt.expectedMaxSessions)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_MaxResultRows(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+	t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+	t.Setenv("NEO4J_MCP_PASSWORD", "password")
+
+	tests := []struct {
+		name     string
+		env      string
+		cli      string
+		expected int32
+	}{
+		{name: "no limit by default", expected: 0},
+		{name: "value from env", env: "500", expected: 500},
+		{name: "invalid value from env", env: "many", expected: 0},
+		{name: "CLI override takes precedence", env: "500", cli: "20", expected: 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NEO4J_MCP_MAX_RESULT_ROWS", tt.env)
+
+			cfg, err := LoadConfig(&CLIOverrides{MaxResultRows: tt.cli})
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error: %v", err)
+			}
+			if cfg.MaxResultRows != tt.expected {
+				t.Errorf("LoadConfig() MaxResultRows = %d, want %d", cfg.MaxResultRows, tt.expected)
+			}
+		})
+	}
+
+	t.Run("negative value is rejected", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_MAX_RESULT_ROWS", "-1")
+
+		if _, err := LoadConfig(nil); err == nil {
+			t.Error("LoadConfig() expected an error for a negative max result rows")
+		}
+	})
+}
+
+func TestLoadConfig_WriteConfirmation(t *testing.T) {
+	t.Setenv("NEO4J_MCP_TRANSPORT_MODE", "stdio")
+	t.Setenv("NEO4J_MCP_URI", "bolt://localhost:7687")
+	t.Setenv("NEO4J_MCP_USERNAME", "neo4j")
+	t.Setenv("NEO4J_MCP_PASSWORD", "password")
+
+	tests := []struct {
+		name              string
+		envThreshold      string
+		envFallback       string
+		cli               CLIOverrides
+		expectedThreshold int32
+		expectedFallback  string
+	}{
+		{name: "defaults", expectedThreshold: DefaultWriteConfirmationThreshold, expectedFallback: WriteConfirmationFallbackAllow},
+		{name: "values from env", envThreshold: "0", envFallback: "DENY", expectedThreshold: 0, expectedFallback: WriteConfirmationFallbackDeny},
+		{
+			name:              "CLI overrides take precedence",
+			envThreshold:      "10",
+			envFallback:       "deny",
+			cli:               CLIOverrides{WriteConfirmationThreshold: "1000", WriteConfirmationFallback: "allow"},
+			expectedThreshold: 1000,
+			expectedFallback:  WriteConfirmationFallbackAllow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NEO4J_MCP_WRITE_CONFIRMATION_THRESHOLD", tt.envThreshold)
+			t.Setenv("NEO4J_MCP_WRITE_CONFIRMATION_FALLBACK", tt.envFallback)
+
+			cfg, err := LoadConfig(&tt.cli)
+			if err != nil {
+				t.Fatalf("LoadConfig() unexpected error: %v", err)
+			}
+			if cfg.WriteConfirmationThreshold != tt.expectedThreshold {
+				t.Errorf("LoadConfig() WriteConfirmationThreshold = %d, want %d", cfg.WriteConfirmationThreshold, tt.expectedThreshold)
+			}
+			if cfg.WriteConfirmationFallback != tt.expectedFallback {
+				t.Errorf("LoadConfig() WriteConfirmationFallback = %q, want %q", cfg.WriteConfirmationFallback, tt.expectedFallback)
+			}
+		})
+	}
+
+	t.Run("negative threshold is rejected", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_WRITE_CONFIRMATION_THRESHOLD", "-1")
+
+		if _, err := LoadConfig(nil); err == nil {
+			t.Error("LoadConfig() expected an error for a negative write confirmation threshold")
+		}
+	})
+
+	t.Run("unknown fallback is rejected", func(t *testing.T) {
+		t.Setenv("NEO4J_MCP_WRITE_CONFIRMATION_FALLBACK", "ask")
+
+		if _, err := LoadConfig(nil); err == nil {
+			t.Error("LoadConfig() expected an error for an unknown write confirmation fallback")
+		}
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package database
+
+import (
+	"context"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+type contextKey string
+
+const (
+	targetDatabaseKey  contextKey = "targetDatabase"
+	queryIDKey         contextKey = "queryID"
+	recordProgressKey  contextKey = "recordProgress"
+	bookmarkManagerKey contextKey = "bookmarkManager"
+)
+
+// WithTargetDatabase adds the database queries should be executed against to the context.
+// It overrides the database the service was created with; callers are responsible for validating the name.
+func WithTargetDatabase(ctx context.Context, database string) context.Context {
+	return context.WithValue(ctx, targetDatabaseKey, database)
+}
+
+// GetTargetDatabase retrieves the target database from the context
+func GetTargetDatabase(ctx context.Context) (string, bool) {
+	database, ok := ctx.Value(targetDatabaseKey).(string)
+	return database, ok && database != ""
+}
+
+// withQueryID adds the id the transaction of a query is tagged with to the context.
+func withQueryID(ctx context.Context, queryID string) context.Context {
+	return context.WithValue(ctx, queryIDKey, queryID)
+}
+
+// getQueryID retrieves the query id from the context
+func getQueryID(ctx context.Context) (string, bool) {
+	queryID, ok := ctx.Value(queryIDKey).(string)
+	return queryID, ok && queryID != ""
+}
+
+// WithRecordProgress adds a function called with the number of records received so far to the context,
+// it is called for every record while the records of a query are streamed.
+func WithRecordProgress(ctx context.Context, report func(records int)) context.Context {
+	return context.WithValue(ctx, recordProgressKey, report)
+}
+
+// getRecordProgress retrieves the record progress function from the context
+func getRecordProgress(ctx context.Context) (func(records int), bool) {
+	report, ok := ctx.Value(recordProgressKey).(func(records int))
+	return report, ok && report != nil
+}
+
+// WithBookmarkManager adds the bookmark manager the queries use to the context, e.g. the one of a stateful HTTP session,
+// so every query sees the changes of the queries run before it with the same manager.
+// Without it the queries use the bookmark manager of the driver.
+func WithBookmarkManager(ctx context.Context, bookmarkManager neo4j.BookmarkManager) context.Context {
+	return context.WithValue(ctx, bookmarkManagerKey, bookmarkManager)
+}
+
+// getBookmarkManager retrieves the bookmark manager from the context
+func getBookmarkManager(ctx context.Context) (neo4j.BookmarkManager, bool) {
+	bookmarkManager, ok := ctx.Value(bookmarkManagerKey).(neo4j.BookmarkManager)
+	return bookmarkManager, ok && bookmarkManager != nil
+}
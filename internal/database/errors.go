@@ -0,0 +1,37 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package database
+
+import (
+	"errors"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// authenticationErrorCodes are the Neo4j error codes of the credentials refused by the server.
+var authenticationErrorCodes = []string{
+	"Neo.ClientError.Security.Unauthorized",
+	"Neo.ClientError.Security.AuthenticationRateLimit",
+	"Neo.ClientError.Security.CredentialsExpired",
+	"Neo.ClientError.Security.TokenExpired",
+}
+
+// IsAuthenticationError reports whether the error, or an error it wraps, is Neo4j refusing the credentials.
+// Retrying with the same credentials fails again, and repeated attempts may lock the user out.
+func IsAuthenticationError(err error) bool {
+	var tokenExpired *neo4j.TokenExpiredError
+	var invalidAuth *neo4j.InvalidAuthenticationError
+	if errors.As(err, &tokenExpired) || errors.As(err, &invalidAuth) {
+		return true
+	}
+	var neo4jErr *neo4j.Neo4jError
+	if errors.As(err, &neo4jErr) {
+		for _, code := range authenticationErrorCodes {
+			if neo4jErr.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
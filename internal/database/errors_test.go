@@ -0,0 +1,36 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package database_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+func TestIsAuthenticationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "unauthorized", err: &neo4j.Neo4jError{Code: "Neo.ClientError.Security.Unauthorized"}, expected: true},
+		{name: "wrapped rate limit", err: fmt.Errorf("failed to execute read query: %w", &neo4j.Neo4jError{Code: "Neo.ClientError.Security.AuthenticationRateLimit"}), expected: true},
+		{name: "expired token", err: &neo4j.TokenExpiredError{Code: "Neo.ClientError.Security.TokenExpired"}, expected: true},
+		{name: "forbidden", err: &neo4j.Neo4jError{Code: "Neo.ClientError.Security.Forbidden"}, expected: false},
+		{name: "syntax error", err: &neo4j.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError"}, expected: false},
+		{name: "other error", err: errors.New("connection refused"), expected: false},
+		{name: "no error", err: nil, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := database.IsAuthenticationError(tt.err); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
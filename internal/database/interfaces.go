@@ -20,11 +20,29 @@ type QueryExecutor interface {
 	// ExecuteWriteQuery executes a write-only Cypher query and returns raw records
 	ExecuteWriteQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error)
 
+	// ExecuteWriteQueryWithSummary executes a write-only Cypher query and returns the raw records
+	// along with the result summary, which carries the update counters.
+	ExecuteWriteQueryWithSummary(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, neo4j.ResultSummary, error)
+
+	// DryRunWriteQuery runs a write Cypher query in a transaction that is always rolled back and returns
+	// the result summary, which carries the update counters the query would produce.
+	// Callers must not dry run procedures committing on their own or CALL { } IN TRANSACTIONS.
+	DryRunWriteQuery(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error)
+
 	// GetQueryType prefixes the provided query with EXPLAIN and returns the query type (e.g. 'r' for read, 'w' for write).
 	// This allows read-only tools to determine if a query modifies database data.
 	// Limitation: custom procedures or functions that are incorrectly classified as read-only by Neo4j
 	// will pass this check. Correct query classification is the responsibility of the procedure/function maintainer.
 	GetQueryType(ctx context.Context, cypher string, params map[string]any) (neo4j.QueryType, error)
+
+	// ExplainQuery prefixes the provided query with EXPLAIN and returns the result summary,
+	// which carries the query plan and the query type. The query is planned but never executed.
+	ExplainQuery(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error)
+
+	// ProfileQuery prefixes the provided query with PROFILE, executes it with read access and returns
+	// the raw records along with the result summary, which carries the profiled plan.
+	// Callers are responsible for verifying that the query is read-only beforehand.
+	ProfileQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, neo4j.ResultSummary, error)
 }
 
 // RecordFormatter defines the interface for formatting Neo4j records
@@ -41,6 +41,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// DryRunWriteQuery mocks base method.
+func (m *MockService) DryRunWriteQuery(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunWriteQuery", ctx, cypher, params)
+	ret0, _ := ret[0].(neo4j.ResultSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunWriteQuery indicates an expected call of DryRunWriteQuery.
+func (mr *MockServiceMockRecorder) DryRunWriteQuery(ctx, cypher, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunWriteQuery", reflect.TypeOf((*MockService)(nil).DryRunWriteQuery), ctx, cypher, params)
+}
+
 // ExecuteReadQuery mocks base method.
 func (m *MockService) ExecuteReadQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +86,37 @@ func (mr *MockServiceMockRecorder) ExecuteWriteQuery(ctx, cypher, params any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteWriteQuery", reflect.TypeOf((*MockService)(nil).ExecuteWriteQuery), ctx, cypher, params)
 }
 
+// ExecuteWriteQueryWithSummary mocks base method.
+func (m *MockService) ExecuteWriteQueryWithSummary(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, neo4j.ResultSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteWriteQueryWithSummary", ctx, cypher, params)
+	ret0, _ := ret[0].([]*neo4j.Record)
+	ret1, _ := ret[1].(neo4j.ResultSummary)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExecuteWriteQueryWithSummary indicates an expected call of ExecuteWriteQueryWithSummary.
+func (mr *MockServiceMockRecorder) ExecuteWriteQueryWithSummary(ctx, cypher, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteWriteQueryWithSummary", reflect.TypeOf((*MockService)(nil).ExecuteWriteQueryWithSummary), ctx, cypher, params)
+}
+
+// ExplainQuery mocks base method.
+func (m *MockService) ExplainQuery(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, cypher, params)
+	ret0, _ := ret[0].(neo4j.ResultSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockServiceMockRecorder) ExplainQuery(ctx, cypher, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockService)(nil).ExplainQuery), ctx, cypher, params)
+}
+
 // GetQueryType mocks base method.
 func (m *MockService) GetQueryType(ctx context.Context, cypher string, params map[string]any) (neo4j.QueryType, error) {
 	m.ctrl.T.Helper()
@@ -100,3 +146,19 @@ func (mr *MockServiceMockRecorder) Neo4jRecordsToJSON(records any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Neo4jRecordsToJSON", reflect.TypeOf((*MockService)(nil).Neo4jRecordsToJSON), records)
 }
+
+// ProfileQuery mocks base method.
+func (m *MockService) ProfileQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, neo4j.ResultSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProfileQuery", ctx, cypher, params)
+	ret0, _ := ret[0].([]*neo4j.Record)
+	ret1, _ := ret[1].(neo4j.ResultSummary)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ProfileQuery indicates an expected call of ProfileQuery.
+func (mr *MockServiceMockRecorder) ProfileQuery(ctx, cypher, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfileQuery", reflect.TypeOf((*MockService)(nil).ProfileQuery), ctx, cypher, params)
+}
@@ -9,19 +9,37 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/neo4j/mcp/internal/auth"
 	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
 )
 
-const appName string = "MCP4NEO4J"
+const (
+	appName string = "MCP4NEO4J"
+
+	// queryIDMetadataKey is the transaction metadata key holding the id every query is tagged with,
+	// so the transaction of a cancelled query can be found with SHOW TRANSACTIONS.
+	queryIDMetadataKey = "mcpQueryId"
+	// terminateTimeout bounds the termination of the transaction of a cancelled query.
+	terminateTimeout = 10 * time.Second
+
+	showQueryTransactionsQuery = `
+        SHOW TRANSACTIONS
+        YIELD transactionId, metaData
+        WHERE metaData.mcpQueryId = $queryId
+        RETURN collect(transactionId) AS transactionIds
+    `
+	terminateTransactionsQuery = "TERMINATE TRANSACTIONS $transactionIds"
+)
 
 // Neo4jService is the concrete implementation of DatabaseService
 type Neo4jService struct {
 	driver          neo4j.Driver
 	database        string
-	transportMode   config.TransportMode // Transport mode (stdio or http)
+	transportMode   config.TransportMode // Transport mode (stdio, http or sse)
 	neo4jMCPVersion string
 }
 
@@ -40,26 +58,36 @@ func NewNeo4jService(driver neo4j.Driver, database string, transportMode config.
 }
 
 // buildQueryOptions builds Neo4j query options based on transport mode.
+// The database is taken from the context when set (see WithTargetDatabase), otherwise the service database is used.
 // For HTTP mode: extracts credentials from context and uses impersonation.
 // Supports both Bearer token auth (preferred for SSO/OAuth) and Basic Auth (fallback).
 // Bearer tokens are passed directly to Neo4j for SSO/OAuth scenarios.
 // If credentials are absent, they are not added to the query options (driver defaults apply).
 // For STDIO mode: uses driver's built-in credentials (no auth token added).
 // The baseOptions parameter allows adding routing-specific options (readers/writers).
-// TxMetadata is added to recognize queries coming from Neo4j MCP.
+// TxMetadata is added to recognize queries coming from Neo4j MCP, along with the query id from the context when set.
+// The bookmark manager from the context is used when set (see WithBookmarkManager), otherwise the driver one.
 func (s *Neo4jService) buildQueryOptions(ctx context.Context, baseOptions ...neo4j.ExecuteQueryConfigurationOption) []neo4j.ExecuteQueryConfigurationOption {
-	txMetadata := neo4j.WithTxMetadata(map[string]any{"app": strings.Join([]string{appName, s.neo4jMCPVersion}, "/")})
+	metadata := map[string]any{"app": strings.Join([]string{appName, s.neo4jMCPVersion}, "/")}
+	if queryID, ok := getQueryID(ctx); ok {
+		metadata[queryIDMetadataKey] = queryID
+	}
+	txMetadata := neo4j.WithTxMetadata(metadata)
 
 	queryOptions := []neo4j.ExecuteQueryConfigurationOption{
-		neo4j.ExecuteQueryWithDatabase(s.database),
+		neo4j.ExecuteQueryWithDatabase(s.targetDatabase(ctx)),
 		neo4j.ExecuteQueryWithTransactionConfig(txMetadata),
 	}
 
+	if bookmarkManager, ok := getBookmarkManager(ctx); ok {
+		queryOptions = append(queryOptions, neo4j.ExecuteQueryWithBookmarkManager(bookmarkManager))
+	}
+
 	// Add any base options (routing, etc.)
 	queryOptions = append(queryOptions, baseOptions...)
 
-	// For HTTP mode, extract credentials from context and use impersonation
-	if s.transportMode == config.TransportModeHTTP {
+	// For HTTP and SSE mode, extract credentials from context and use impersonation
+	if s.transportMode.IsHTTP() {
 		authToken := s.getHTTPAuthToken(ctx)
 		if authToken != nil {
 			queryOptions = append(queryOptions, neo4j.ExecuteQueryWithAuthToken(*authToken))
@@ -69,6 +97,14 @@ func (s *Neo4jService) buildQueryOptions(ctx context.Context, baseOptions ...neo
 	return queryOptions
 }
 
+// targetDatabase returns the database from the context, falling back to the service database.
+func (s *Neo4jService) targetDatabase(ctx context.Context) string {
+	if database, ok := GetTargetDatabase(ctx); ok {
+		return database
+	}
+	return s.database
+}
+
 // Collect HTTP Auth token from Context.
 func (s *Neo4jService) getHTTPAuthToken(ctx context.Context) *neo4j.AuthToken {
 	if token, hasBearerToken := auth.GetBearerToken(ctx); hasBearerToken {
@@ -84,9 +120,7 @@ func (s *Neo4jService) getHTTPAuthToken(ctx context.Context) *neo4j.AuthToken {
 
 // ExecuteReadQuery executes a read-only Cypher query and returns raw records
 func (s *Neo4jService) ExecuteReadQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
-	queryOptions := s.buildQueryOptions(ctx, neo4j.ExecuteQueryWithReadersRouting())
-
-	res, err := neo4j.ExecuteQuery(ctx, s.driver, cypher, params, neo4j.EagerResultTransformer, queryOptions...)
+	res, err := s.executeQuery(ctx, cypher, params, neo4j.ExecuteQueryWithReadersRouting())
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to execute read query: %w", err)
 		slog.Error("Error in ExecuteReadQuery", "error", wrappedErr)
@@ -99,16 +133,80 @@ func (s *Neo4jService) ExecuteReadQuery(ctx context.Context, cypher string, para
 
 // ExecuteWriteQuery executes a write-only Cypher query and returns raw records
 func (s *Neo4jService) ExecuteWriteQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, error) {
-	queryOptions := s.buildQueryOptions(ctx, neo4j.ExecuteQueryWithWritersRouting())
+	records, _, err := s.ExecuteWriteQueryWithSummary(ctx, cypher, params)
+	return records, err
+}
 
-	res, err := neo4j.ExecuteQuery(ctx, s.driver, cypher, params, neo4j.EagerResultTransformer, queryOptions...)
+// ExecuteWriteQueryWithSummary executes a write-only Cypher query and returns the raw records
+// along with the result summary, which carries the update counters.
+func (s *Neo4jService) ExecuteWriteQueryWithSummary(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, neo4j.ResultSummary, error) {
+	res, err := s.executeQuery(ctx, cypher, params, neo4j.ExecuteQueryWithWritersRouting())
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to execute write query: %w", err)
 		slog.Error("Error in ExecuteWriteQuery", "error", wrappedErr)
+		return nil, nil, wrappedErr
+	}
+
+	return res.Records, res.Summary, nil
+}
+
+// DryRunWriteQuery runs a write Cypher query in a transaction that is always rolled back and returns
+// the result summary, which carries the update counters the query would produce.
+// Callers must not dry run procedures committing on their own, e.g. apoc.periodic.iterate, or CALL { } IN TRANSACTIONS:
+// their changes escape the rollback.
+func (s *Neo4jService) DryRunWriteQuery(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error) {
+	queryID := uuid.NewString()
+	ctx = withQueryID(ctx, queryID)
+	baseOptions := []neo4j.ExecuteQueryConfigurationOption{neo4j.ExecuteQueryWithWritersRouting()}
+
+	// The session gets the database, credentials, bookmarks and metadata of the queries run with ExecuteQuery
+	queryConfig := &neo4j.ExecuteQueryConfiguration{}
+	for _, option := range s.buildQueryOptions(ctx, baseOptions...) {
+		option(queryConfig)
+	}
+	if queryConfig.BookmarkManager == nil {
+		queryConfig.BookmarkManager = s.driver.ExecuteQueryBookmarkManager()
+	}
+	session := s.driver.NewSession(ctx, neo4j.SessionConfig{
+		AccessMode:      neo4j.AccessModeWrite,
+		DatabaseName:    queryConfig.Database,
+		Auth:            queryConfig.Auth,
+		BookmarkManager: queryConfig.BookmarkManager,
+	})
+	defer func() {
+		_ = session.Close(context.WithoutCancel(ctx))
+	}()
+
+	summary, err := dryRun(ctx, session, cypher, params, queryConfig.TransactionConfigurers)
+	if err != nil {
+		if ctx.Err() != nil {
+			s.terminateTransactions(ctx, queryID, baseOptions...)
+		}
+		wrappedErr := fmt.Errorf("failed to dry run write query: %w", err)
+		slog.Error("Error in DryRunWriteQuery", "error", wrappedErr)
 		return nil, wrappedErr
 	}
 
-	return res.Records, nil
+	return summary, nil
+}
+
+// dryRun runs the query in an explicit transaction of the session and rolls it back.
+func dryRun(ctx context.Context, session neo4j.Session, cypher string, params map[string]any, txConfigurers []func(*neo4j.TransactionConfig)) (neo4j.ResultSummary, error) {
+	tx, err := session.BeginTransaction(ctx, txConfigurers...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(context.WithoutCancel(ctx)); err != nil {
+			slog.Warn("failed to roll back the dry run transaction", "error", err)
+		}
+	}()
+
+	result, err := tx.Run(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.Consume(ctx)
 }
 
 // GetQueryType prefixes the provided query with EXPLAIN and returns the query type (e.g. 'r' for read, 'w' for write).
@@ -116,25 +214,135 @@ func (s *Neo4jService) ExecuteWriteQuery(ctx context.Context, cypher string, par
 // Limitation: custom procedures or functions that are incorrectly classified as read-only by Neo4j
 // will pass this check. Correct query classification is the responsibility of the procedure/function maintainer.
 func (s *Neo4jService) GetQueryType(ctx context.Context, cypher string, params map[string]any) (neo4j.QueryType, error) {
-	explainedQuery := strings.Join([]string{"EXPLAIN", cypher}, " ")
-
-	queryOptions := s.buildQueryOptions(ctx)
-
-	res, err := neo4j.ExecuteQuery(ctx, s.driver, explainedQuery, params, neo4j.EagerResultTransformer, queryOptions...)
+	summary, err := s.explain(ctx, cypher, params)
 	if err != nil {
 		wrappedErr := fmt.Errorf("error during GetQueryType: %w", err)
 		slog.Error("Error during GetQueryType", "error", wrappedErr)
 		return neo4j.QueryTypeUnknown, wrappedErr
 	}
 
+	return summary.QueryType(), nil
+}
+
+// ExplainQuery prefixes the provided query with EXPLAIN and returns the result summary,
+// which carries the query plan and the query type. The query is planned but never executed.
+func (s *Neo4jService) ExplainQuery(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error) {
+	summary, err := s.explain(ctx, cypher, params)
+	if err != nil {
+		wrappedErr := fmt.Errorf("error during ExplainQuery: %w", err)
+		slog.Error("Error during ExplainQuery", "error", wrappedErr)
+		return nil, wrappedErr
+	}
+
+	return summary, nil
+}
+
+// ProfileQuery prefixes the provided query with PROFILE, executes it with read access and returns
+// the raw records along with the result summary, which carries the profiled plan.
+// Callers are responsible for verifying that the query is read-only beforehand.
+func (s *Neo4jService) ProfileQuery(ctx context.Context, cypher string, params map[string]any) ([]*neo4j.Record, neo4j.ResultSummary, error) {
+	profiledQuery := strings.Join([]string{"PROFILE", cypher}, " ")
+
+	res, err := s.executeQuery(ctx, profiledQuery, params, neo4j.ExecuteQueryWithReadersRouting())
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to execute profiled query: %w", err)
+		slog.Error("Error in ProfileQuery", "error", wrappedErr)
+		return nil, nil, wrappedErr
+	}
+
 	if res.Summary == nil {
-		err := fmt.Errorf("error during GetQueryType: no summary returned for explained query")
-		slog.Error("Error during GetQueryType", "error", err)
-		return neo4j.QueryTypeUnknown, err
+		err := fmt.Errorf("failed to execute profiled query: no summary returned")
+		slog.Error("Error in ProfileQuery", "error", err)
+		return nil, nil, err
+	}
+
+	return res.Records, res.Summary, nil
+}
+
+// explain runs the provided query prefixed with EXPLAIN and returns its summary.
+func (s *Neo4jService) explain(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultSummary, error) {
+	explainedQuery := strings.Join([]string{"EXPLAIN", cypher}, " ")
+
+	res, err := s.executeQuery(ctx, explainedQuery, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Summary == nil {
+		return nil, fmt.Errorf("no summary returned for explained query")
+	}
+
+	return res.Summary, nil
+}
+
+// executeQuery runs the query in a transaction tagged with a new query id.
+// When the context is cancelled while the query runs, e.g. by an MCP cancellation or a closed HTTP connection,
+// the driver rolls the transaction back, but the server may keep running the query until it next reaches the client:
+// the tagged transaction is then terminated as well.
+func (s *Neo4jService) executeQuery(ctx context.Context, cypher string, params map[string]any, baseOptions ...neo4j.ExecuteQueryConfigurationOption) (*neo4j.EagerResult, error) {
+	queryID := uuid.NewString()
+	ctx = withQueryID(ctx, queryID)
+
+	transformer := neo4j.EagerResultTransformer
+	if report, ok := getRecordProgress(ctx); ok {
+		transformer = func() neo4j.ResultTransformer[*neo4j.EagerResult] {
+			return &progressResultTransformer{ResultTransformer: neo4j.EagerResultTransformer(), report: report}
+		}
+	}
+
+	res, err := neo4j.ExecuteQuery(ctx, s.driver, cypher, params, transformer, s.buildQueryOptions(ctx, baseOptions...)...)
+	if err != nil && ctx.Err() != nil {
+		s.terminateTransactions(ctx, queryID, baseOptions...)
 	}
+	return res, err
+}
 
-	return res.Summary.QueryType(), nil
+// progressResultTransformer collects the records like neo4j.EagerResultTransformer
+// and reports the number of records received so far, see WithRecordProgress.
+type progressResultTransformer struct {
+	neo4j.ResultTransformer[*neo4j.EagerResult]
+	report  func(records int)
+	records int
+}
 
+func (t *progressResultTransformer) Accept(record *neo4j.Record) error {
+	if err := t.ResultTransformer.Accept(record); err != nil {
+		return err
+	}
+	t.records++
+	t.report(t.records)
+	return nil
+}
+
+// terminateTransactions terminates the transactions tagged with the query id, with the credentials and routing of the query.
+// In a cluster SHOW TRANSACTIONS only lists the transactions of the server it runs on, which is the server of the
+// query unless the routing picked another member. Failures are logged, the query has failed already.
+func (s *Neo4jService) terminateTransactions(ctx context.Context, queryID string, baseOptions ...neo4j.ExecuteQueryConfigurationOption) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), terminateTimeout)
+	defer cancel()
+	// The termination queries get their own id, so they never match the query id themselves
+	ctx = withQueryID(ctx, uuid.NewString())
+	queryOptions := s.buildQueryOptions(ctx, baseOptions...)
+
+	res, err := neo4j.ExecuteQuery(ctx, s.driver, showQueryTransactionsQuery, map[string]any{"queryId": queryID}, neo4j.EagerResultTransformer, queryOptions...)
+	if err != nil {
+		slog.Error("failed to find the transaction of a cancelled query", "queryId", queryID, "error", err)
+		return
+	}
+	var transactionIDs []any
+	if len(res.Records) == 1 {
+		transactionIDs, _, _ = neo4j.GetRecordValue[[]any](res.Records[0], "transactionIds")
+	}
+	if len(transactionIDs) == 0 {
+		slog.Debug("no transaction left to terminate for the cancelled query", "queryId", queryID)
+		return
+	}
+
+	if _, err := neo4j.ExecuteQuery(ctx, s.driver, terminateTransactionsQuery, map[string]any{"transactionIds": transactionIDs}, neo4j.EagerResultTransformer, queryOptions...); err != nil {
+		slog.Error("failed to terminate the transaction of a cancelled query", "queryId", queryID, "transactionIds", transactionIDs, "error", err)
+		return
+	}
+	slog.Info("terminated the transaction of a cancelled query", "queryId", queryID, "transactionIds", transactionIDs)
 }
 
 // Neo4jRecordsToJSON converts Neo4j records to JSON string
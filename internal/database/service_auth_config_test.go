@@ -144,3 +144,83 @@ func TestBuildQueryOptions_STDIOMode_BasicAuthIgnored(t *testing.T) {
 		t.Errorf("Expected no auth token in STDIO mode, got %+v", config.Auth)
 	}
 }
+
+// TestBuildQueryOptions_TargetDatabaseFromContext verifies that the database
+// set in the context overrides the service database.
+func TestBuildQueryOptions_TargetDatabaseFromContext(t *testing.T) {
+	service := &Neo4jService{
+		driver:        nil,
+		database:      "testdb",
+		transportMode: config.TransportModeHTTP,
+	}
+
+	ctx := WithTargetDatabase(context.Background(), "movies")
+
+	options := service.buildQueryOptions(ctx)
+	config := applyOptions(options)
+
+	if config.Database != "movies" {
+		t.Errorf("Expected database 'movies', got %q", config.Database)
+	}
+
+	// An empty target database falls back to the service database
+	options = service.buildQueryOptions(WithTargetDatabase(context.Background(), ""))
+	config = applyOptions(options)
+
+	if config.Database != "testdb" {
+		t.Errorf("Expected database 'testdb', got %q", config.Database)
+	}
+}
+
+// TestBuildQueryOptions_QueryIDMetadata verifies that the query id from the context
+// tags the transaction, so a cancelled query can be terminated.
+func TestBuildQueryOptions_QueryIDMetadata(t *testing.T) {
+	service := &Neo4jService{
+		driver:          nil,
+		database:        "testdb",
+		transportMode:   config.TransportModeStdio,
+		neo4jMCPVersion: "test-version",
+	}
+
+	txMetadata := func(ctx context.Context) map[string]any {
+		txConfig := &neo4j.TransactionConfig{}
+		for _, configurer := range applyOptions(service.buildQueryOptions(ctx)).TransactionConfigurers {
+			configurer(txConfig)
+		}
+		return txConfig.Metadata
+	}
+
+	metadata := txMetadata(withQueryID(context.Background(), "query-1"))
+	if metadata["mcpQueryId"] != "query-1" {
+		t.Errorf("Expected the query id in the metadata, got %v", metadata)
+	}
+	if metadata["app"] != "MCP4NEO4J/test-version" {
+		t.Errorf("Expected the app in the metadata, got %v", metadata)
+	}
+
+	if metadata := txMetadata(context.Background()); metadata["mcpQueryId"] != nil {
+		t.Errorf("Expected no query id without one in the context, got %v", metadata)
+	}
+}
+
+// TestBuildQueryOptions_BookmarkManagerFromContext verifies that the queries of a stateful session
+// use its bookmark manager.
+func TestBuildQueryOptions_BookmarkManagerFromContext(t *testing.T) {
+	service := &Neo4jService{
+		driver:        nil,
+		database:      "testdb",
+		transportMode: config.TransportModeHTTP,
+	}
+
+	bookmarkManager := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+	config := applyOptions(service.buildQueryOptions(WithBookmarkManager(context.Background(), bookmarkManager)))
+	if config.BookmarkManager != bookmarkManager {
+		t.Errorf("Expected the bookmark manager from the context, got %v", config.BookmarkManager)
+	}
+
+	// Without one the driver bookmark manager applies
+	config = applyOptions(service.buildQueryOptions(context.Background()))
+	if config.BookmarkManager != nil {
+		t.Errorf("Expected no bookmark manager, got %v", config.BookmarkManager)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package database
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+func TestProgressResultTransformer(t *testing.T) {
+	var reported []int
+	ctx := WithRecordProgress(context.Background(), func(records int) {
+		reported = append(reported, records)
+	})
+	report, ok := getRecordProgress(ctx)
+	if !ok {
+		t.Fatal("Expected the record progress function in the context")
+	}
+
+	transformer := &progressResultTransformer{ResultTransformer: neo4j.EagerResultTransformer(), report: report}
+	for i := range 3 {
+		if err := transformer.Accept(&neo4j.Record{Keys: []string{"n"}, Values: []any{int64(i)}}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	result, err := transformer.Complete([]string{"n"}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result.Records) != 3 {
+		t.Errorf("Expected the 3 records in the result, got %d", len(result.Records))
+	}
+	if !slices.Equal(reported, []int{1, 2, 3}) {
+		t.Errorf("Expected the records to be reported one by one, got %v", reported)
+	}
+
+	if _, ok := getRecordProgress(context.Background()); ok {
+		t.Error("Expected no record progress function without one in the context")
+	}
+}
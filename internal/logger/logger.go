@@ -7,12 +7,14 @@ import (
 	"io"
 	"log/slog"
 	"strings"
+	"sync/atomic"
 )
 
 // Service holds the logger and its level controller.
 type Service struct {
 	*slog.Logger
-	level *slog.LevelVar
+	level    *slog.LevelVar
+	notifier *atomic.Pointer[Notifier]
 }
 
 // Global logger instance for Phase 1 (stdio mode)
@@ -91,10 +93,12 @@ func New(level, format string, writer io.Writer) *Service {
 		handler = slog.NewTextHandler(writer, opts)
 	}
 
-	// Create the logger service
+	// Create the logger service, the records are forwarded to the MCP clients once a notifier is set
+	notifier := &atomic.Pointer[Notifier]{}
 	service := &Service{
-		Logger: slog.New(handler),
-		level:  levelVar,
+		Logger:   slog.New(&notifyingHandler{Handler: handler, notifier: notifier}),
+		level:    levelVar,
+		notifier: notifier,
 	}
 
 	return service
@@ -149,29 +153,12 @@ func IsSensitiveKey(key string) bool {
 }
 
 // replaceAttr is a slog.HandlerOptions.ReplaceAttr function that customizes
-// log level attribute formatting. It maps log levels to uppercase string
-// representations using range-based switch cases (following slog custom levels pattern).
+// log level attribute formatting. It maps log levels to the uppercase MCP level names, see LevelName.
 // It also redacts sensitive information from log attributes based on predefined keys.
 func replaceAttr(_ []string, a slog.Attr) slog.Attr {
 	if a.Key == slog.LevelKey {
-		level := a.Value.Any().(slog.Level)
-		switch {
-		case level < LevelInfo:
-			a.Value = slog.StringValue("DEBUG")
-		case level < LevelNotice:
-			a.Value = slog.StringValue("INFO")
-		case level < LevelWarning:
-			a.Value = slog.StringValue("NOTICE")
-		case level < LevelError:
-			a.Value = slog.StringValue("WARNING")
-		case level < LevelCritical:
-			a.Value = slog.StringValue("ERROR")
-		case level < LevelAlert:
-			a.Value = slog.StringValue("CRITICAL")
-		case level < LevelEmergency:
-			a.Value = slog.StringValue("ALERT")
-		default:
-			a.Value = slog.StringValue("EMERGENCY")
+		if level, ok := a.Value.Any().(slog.Level); ok {
+			a.Value = slog.StringValue(strings.ToUpper(LevelName(level)))
 		}
 	}
 
@@ -0,0 +1,158 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+)
+
+// Notifier forwards log records to MCP clients, as notifications/message.
+// Its methods are called for every record and must not log themselves.
+type Notifier interface {
+	// Enabled reports whether a client wants the records of the level.
+	Enabled(level slog.Level) bool
+	// Notify forwards a record with its MCP level name, data holds the message and the attributes,
+	// redacted like the log output.
+	Notify(level string, data map[string]any)
+}
+
+// SetNotifier forwards the records of this Service instance to the notifier as well, nil stops forwarding.
+func (s *Service) SetNotifier(notifier Notifier) {
+	if notifier == nil {
+		s.notifier.Store(nil)
+		return
+	}
+	s.notifier.Store(&notifier)
+}
+
+// SetNotifier forwards the records of the global logger to the notifier as well, nil stops forwarding.
+func SetNotifier(notifier Notifier) {
+	if defaultService != nil {
+		defaultService.SetNotifier(notifier)
+	}
+}
+
+// LevelName returns the MCP log level name of a slog level, e.g. "warning".
+func LevelName(level slog.Level) string {
+	switch {
+	case level < LevelInfo:
+		return "debug"
+	case level < LevelNotice:
+		return "info"
+	case level < LevelWarning:
+		return "notice"
+	case level < LevelError:
+		return "warning"
+	case level < LevelCritical:
+		return "error"
+	case level < LevelAlert:
+		return "critical"
+	case level < LevelEmergency:
+		return "alert"
+	default:
+		return "emergency"
+	}
+}
+
+// notifyingHandler writes the records with the wrapped handler, at the level of the Service,
+// and forwards them to the notifier, at the level selected by the clients.
+type notifyingHandler struct {
+	slog.Handler
+	notifier *atomic.Pointer[Notifier]
+	attrs    []groupedAttr // the attributes added with WithAttrs
+	groups   []string
+}
+
+// groupedAttr is an attribute with the groups opened when it was added.
+type groupedAttr struct {
+	groups []string
+	attr   slog.Attr
+}
+
+func (h *notifyingHandler) loadNotifier() Notifier {
+	if notifier := h.notifier.Load(); notifier != nil {
+		return *notifier
+	}
+	return nil
+}
+
+func (h *notifyingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.Handler.Enabled(ctx, level) {
+		return true
+	}
+	notifier := h.loadNotifier()
+	return notifier != nil && notifier.Enabled(level)
+}
+
+func (h *notifyingHandler) Handle(ctx context.Context, record slog.Record) error {
+	var err error
+	if h.Handler.Enabled(ctx, record.Level) {
+		err = h.Handler.Handle(ctx, record)
+	}
+
+	notifier := h.loadNotifier()
+	if notifier == nil || !notifier.Enabled(record.Level) {
+		return err
+	}
+	data := map[string]any{"message": record.Message}
+	for _, grouped := range h.attrs {
+		addAttr(data, grouped.groups, grouped.attr)
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		addAttr(data, h.groups, attr)
+		return true
+	})
+	notifier.Notify(LevelName(record.Level), data)
+	return err
+}
+
+func (h *notifyingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	grouped := make([]groupedAttr, 0, len(h.attrs)+len(attrs))
+	grouped = append(grouped, h.attrs...)
+	for _, attr := range attrs {
+		grouped = append(grouped, groupedAttr{groups: h.groups, attr: attr})
+	}
+	return &notifyingHandler{Handler: h.Handler.WithAttrs(attrs), notifier: h.notifier, attrs: grouped, groups: h.groups}
+}
+
+func (h *notifyingHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &notifyingHandler{Handler: h.Handler.WithGroup(name), notifier: h.notifier, attrs: h.attrs, groups: append(h.groups[:len(h.groups):len(h.groups)], name)}
+}
+
+// addAttr adds an attribute to the notification data, redacted with replaceAttr.
+// Groups are flattened, their keys are joined with dots.
+func addAttr(data map[string]any, groups []string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			groups = append(groups[:len(groups):len(groups)], attr.Key)
+		}
+		for _, member := range attr.Value.Group() {
+			addAttr(data, groups, member)
+		}
+		return
+	}
+	if attr.Key == "" {
+		return
+	}
+	attr = replaceAttr(groups, attr)
+	value := attr.Value.Any()
+	if err, ok := value.(error); ok {
+		value = err.Error()
+	}
+	data[groupKey(groups, attr.Key)] = value
+}
+
+func groupKey(groups []string, key string) string {
+	if len(groups) == 0 {
+		return key
+	}
+	return strings.Join(groups, ".") + "." + key
+}
@@ -0,0 +1,145 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package logger_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/logger"
+)
+
+type notification struct {
+	level string
+	data  map[string]any
+}
+
+// fakeNotifier collects the records at or above its level.
+type fakeNotifier struct {
+	level         slog.Level
+	notifications []notification
+}
+
+func (n *fakeNotifier) Enabled(level slog.Level) bool { return level >= n.level }
+
+func (n *fakeNotifier) Notify(level string, data map[string]any) {
+	n.notifications = append(n.notifications, notification{level: level, data: data})
+}
+
+func TestNotifier(t *testing.T) {
+	t.Run("forwards the records at the notifier level, whatever the log level", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		log := logger.New("error", "text", buf)
+		notifier := &fakeNotifier{level: logger.LevelDebug}
+		log.SetNotifier(notifier)
+
+		log.Debug("debug message", "query", "RETURN 1")
+		log.Log(t.Context(), logger.LevelCritical, "critical message")
+
+		if strings.Contains(buf.String(), "debug message") {
+			t.Error("Expected debug message to NOT appear in the log output at error level")
+		}
+		if !strings.Contains(buf.String(), "critical message") {
+			t.Error("Expected critical message to appear in the log output")
+		}
+		if len(notifier.notifications) != 2 {
+			t.Fatalf("Expected 2 notifications, got %d", len(notifier.notifications))
+		}
+		first := notifier.notifications[0]
+		if first.level != "debug" || first.data["message"] != "debug message" || first.data["query"] != "RETURN 1" {
+			t.Errorf("Unexpected notification: %+v", first)
+		}
+		if level := notifier.notifications[1].level; level != "critical" {
+			t.Errorf("Expected critical level, got %s", level)
+		}
+	})
+
+	t.Run("records below the notifier level are not forwarded", func(t *testing.T) {
+		log := logger.New("debug", "text", &bytes.Buffer{})
+		notifier := &fakeNotifier{level: logger.LevelWarning}
+		log.SetNotifier(notifier)
+
+		log.Info("info message")
+		log.Warn("warning message")
+
+		if len(notifier.notifications) != 1 || notifier.notifications[0].level != "warning" {
+			t.Errorf("Expected only the warning to be forwarded, got %+v", notifier.notifications)
+		}
+	})
+
+	t.Run("sensitive attributes are redacted and groups flattened", func(t *testing.T) {
+		log := logger.New("info", "text", &bytes.Buffer{})
+		notifier := &fakeNotifier{level: logger.LevelInfo}
+		log.SetNotifier(notifier)
+
+		log.With("password", "secret123").WithGroup("connection").Info("connecting",
+			"host", "db.example.com",
+			"database", "neo4j",
+			"error", errors.New("connection refused"))
+
+		if len(notifier.notifications) != 1 {
+			t.Fatalf("Expected 1 notification, got %d", len(notifier.notifications))
+		}
+		data := notifier.notifications[0].data
+		expected := map[string]any{
+			"message":             "connecting",
+			"password":            "[REDACTED]",
+			"connection.host":     "[REDACTED]",
+			"connection.database": "neo4j",
+			"connection.error":    "connection refused",
+		}
+		for key, value := range expected {
+			if data[key] != value {
+				t.Errorf("Expected %s to be %v, got %v", key, value, data[key])
+			}
+		}
+	})
+
+	t.Run("nil notifier stops forwarding", func(t *testing.T) {
+		log := logger.New("info", "text", &bytes.Buffer{})
+		notifier := &fakeNotifier{level: logger.LevelDebug}
+		log.SetNotifier(notifier)
+		log.SetNotifier(nil)
+
+		log.Error("error message")
+
+		if len(notifier.notifications) != 0 {
+			t.Errorf("Expected no notification, got %d", len(notifier.notifications))
+		}
+	})
+}
+
+func TestLevelName(t *testing.T) {
+	for _, name := range logger.ValidLogLevels {
+		buf := &bytes.Buffer{}
+		log := logger.New(name, "text", buf)
+		notifier := &fakeNotifier{level: logger.LevelDebug}
+		log.SetNotifier(notifier)
+
+		log.Log(t.Context(), levelOf(name), "message")
+
+		if len(notifier.notifications) != 1 || notifier.notifications[0].level != name {
+			t.Errorf("Expected level %s to be forwarded as %s, got %+v", name, name, notifier.notifications)
+		}
+		if !strings.Contains(buf.String(), "level="+strings.ToUpper(name)) {
+			t.Errorf("Expected level %s in the log output, got %s", strings.ToUpper(name), buf.String())
+		}
+	}
+}
+
+func levelOf(name string) slog.Level {
+	return map[string]slog.Level{
+		"debug":     logger.LevelDebug,
+		"info":      logger.LevelInfo,
+		"notice":    logger.LevelNotice,
+		"warning":   logger.LevelWarning,
+		"error":     logger.LevelError,
+		"critical":  logger.LevelCritical,
+		"alert":     logger.LevelAlert,
+		"emergency": logger.LevelEmergency,
+	}[name]
+}
@@ -0,0 +1,62 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+const defaultMaxFindings = 10
+
+func DataQualityReviewSpec() mcp.Prompt {
+	return mcp.NewPrompt("data-quality-review",
+		mcp.WithPromptTitle("Review data quality"),
+		mcp.WithPromptDescription("Review the data quality of the database with read-only queries: missing properties, duplicates, orphan nodes and inconsistent types."),
+		mcp.WithArgument("labels",
+			mcp.ArgumentDescription("Comma separated node labels to review, defaults to every label"),
+		),
+		mcp.WithArgument("maxFindings",
+			mcp.ArgumentDescription(fmt.Sprintf("Maximum number of findings to report, a positive integer, defaults to %d", defaultMaxFindings)),
+		),
+		databaseArgument,
+	)
+}
+
+func DataQualityReviewHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *cypher.SchemaCache) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		maxFindings := defaultMaxFindings
+		if raw := strings.TrimSpace(request.Params.Arguments["maxFindings"]); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed <= 0 {
+				return nil, fmt.Errorf("maxFindings must be a positive integer, got %q", raw)
+			}
+			maxFindings = parsed
+		}
+		// The rich schema lists the indexed, unique and mandatory properties the review checks
+		filter := cypher.SchemaFilter{Labels: listArgument(request, "labels")}
+		schema, err := schemaSection(ctx, deps, schemaSampleSize, cache, request.Params.Arguments["database"], true, filter)
+		if err != nil {
+			slog.Error("failed to build the data-quality-review prompt", "error", err)
+			return nil, err
+		}
+
+		instructions := fmt.Sprintf(`Review the data quality of the labels of this schema with read-cypher, without modifying any data:
+- Nodes missing a property that most nodes of their label have, and mandatory properties that are null.
+- Duplicate nodes sharing the value of a property that looks like a key but has no uniqueness constraint.
+- Orphan nodes without any relationship, for labels that usually have some.
+- Properties holding values of several types, e.g. a date stored both as a string and as a date.
+- Relationships connecting unexpected labels.
+Use count queries and small samples with LIMIT, as the graph may be large.
+Report at most %d findings, the most significant first, each with the query that shows it and a suggested fix.`, maxFindings)
+		return mcp.NewGetPromptResult("Review data quality", userMessages(schema, instructions)), nil
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/prompts"
+	"github.com/neo4j/mcp/internal/tools"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataQualityReviewPrompt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("embeds the rich schema", func(t *testing.T) {
+		handler := prompts.DataQualityReviewHandler(schemaDeps(ctrl), 100, nil)
+		result, err := handler(context.Background(), getPromptRequest(map[string]string{"labels": "Person", "maxFindings": "3"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		text := promptText(t, result)
+		for _, expected := range []string{`"unique":["name"]`, "at most 3 findings"} {
+			if !strings.Contains(text, expected) {
+				t.Errorf("Expected the prompt to contain %q, got:\n%s", expected, text)
+			}
+		}
+		if strings.Contains(text, `"key":"Movie"`) {
+			t.Errorf("Expected the schema scoped to Person, got:\n%s", text)
+		}
+	})
+
+	t.Run("invalid maxFindings", func(t *testing.T) {
+		handler := prompts.DataQualityReviewHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl)}, 100, nil)
+		_, err := handler(context.Background(), getPromptRequest(map[string]string{"maxFindings": "many"}))
+		if err == nil || !strings.Contains(err.Error(), "maxFindings must be a positive integer") {
+			t.Errorf("Expected an invalid maxFindings error, got: %v", err)
+		}
+	})
+}
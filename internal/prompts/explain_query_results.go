@@ -0,0 +1,53 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func ExplainQueryResultsSpec() mcp.Prompt {
+	return mcp.NewPrompt("explain-query-results",
+		mcp.WithPromptTitle("Explain query results"),
+		mcp.WithPromptDescription("Explain the results of a Cypher query in plain language."),
+		mcp.WithArgument("query",
+			mcp.ArgumentDescription("The Cypher query that was run"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("results",
+			mcp.ArgumentDescription("The results returned by the query, e.g. the JSON output of read-cypher"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("question",
+			mcp.ArgumentDescription("The question the query was meant to answer"),
+		),
+	)
+}
+
+// ExplainQueryResultsHandler builds a prompt from its arguments only, it does not read the database.
+func ExplainQueryResultsHandler() func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(_ context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		query, err := requiredArgument(request, "query")
+		if err != nil {
+			return nil, err
+		}
+		results, err := requiredArgument(request, "results")
+		if err != nil {
+			return nil, err
+		}
+
+		var text strings.Builder
+		text.WriteString("Explain in plain language what the results of this Cypher query show.\n")
+		if question := strings.TrimSpace(request.Params.Arguments["question"]); question != "" {
+			text.WriteString("The query was meant to answer: " + question + "\n")
+		}
+		text.WriteString("Describe what the query matches, summarize the results and point out empty, null or unexpected values.\n")
+		text.WriteString("Query:\n```cypher\n" + query + "\n```\n")
+		text.WriteString("Results:\n```json\n" + results + "\n```")
+		return mcp.NewGetPromptResult("Explain query results", userMessages(text.String())), nil
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/prompts"
+)
+
+func TestExplainQueryResultsPrompt(t *testing.T) {
+	t.Run("embeds the query, results and question", func(t *testing.T) {
+		handler := prompts.ExplainQueryResultsHandler()
+		result, err := handler(context.Background(), getPromptRequest(map[string]string{
+			"query":    "MATCH (m:Movie) RETURN count(m) AS movies",
+			"results":  `[{"movies": 38}]`,
+			"question": "How many movies are there?",
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		text := promptText(t, result)
+		for _, expected := range []string{"MATCH (m:Movie) RETURN count(m) AS movies", `[{"movies": 38}]`, "How many movies are there?"} {
+			if !strings.Contains(text, expected) {
+				t.Errorf("Expected the prompt to contain %q, got:\n%s", expected, text)
+			}
+		}
+	})
+
+	t.Run("requires the results", func(t *testing.T) {
+		handler := prompts.ExplainQueryResultsHandler()
+		_, err := handler(context.Background(), getPromptRequest(map[string]string{"query": "RETURN 1"}))
+		if err == nil || !strings.Contains(err.Error(), "results") {
+			t.Errorf("Expected a missing results error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+func ExploreGraphSpec() mcp.Prompt {
+	return mcp.NewPrompt("explore-graph",
+		mcp.WithPromptTitle("Explore the graph"),
+		mcp.WithPromptDescription("Explore the data of the database step by step, optionally around a node label, and summarize what it contains."),
+		mcp.WithArgument("label",
+			mcp.ArgumentDescription("A node label to focus on, the schema is scoped to it and its neighbors"),
+		),
+		mcp.WithArgument("goal",
+			mcp.ArgumentDescription("What the exploration should find out, in natural language"),
+		),
+		databaseArgument,
+	)
+}
+
+func ExploreGraphHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *cypher.SchemaCache) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		label := strings.TrimSpace(request.Params.Arguments["label"])
+		schema, err := schemaSection(ctx, deps, schemaSampleSize, cache, request.Params.Arguments["database"], false, cypher.SchemaFilter{NeighborsOf: label})
+		if err != nil {
+			slog.Error("failed to build the explore-graph prompt", "error", err)
+			return nil, err
+		}
+
+		var instructions strings.Builder
+		instructions.WriteString("Explore the graph with the tools of this server, one step at a time:\n")
+		instructions.WriteString("1. Call get-graph-stats to learn how many nodes and relationships of each kind exist.\n")
+		if label != "" {
+			instructions.WriteString("2. Sample a few " + label + " nodes with read-cypher, using LIMIT.\n")
+		} else {
+			instructions.WriteString("2. Sample a few nodes of the main labels with read-cypher, using LIMIT.\n")
+		}
+		instructions.WriteString("3. Expand around interesting nodes with get-neighborhood, and connect them with find-paths.\n")
+		instructions.WriteString("4. Summarize the main entities, how they relate and anything surprising, citing the queries used.")
+		if goal := strings.TrimSpace(request.Params.Arguments["goal"]); goal != "" {
+			instructions.WriteString("\nThe exploration should find out: " + goal)
+		}
+		return mcp.NewGetPromptResult("Explore the graph", userMessages(schema, instructions.String())), nil
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/prompts"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExploreGraphPrompt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("whole graph", func(t *testing.T) {
+		handler := prompts.ExploreGraphHandler(schemaDeps(ctrl), 100, nil)
+		result, err := handler(context.Background(), getPromptRequest(map[string]string{"goal": "who acted the most"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		text := promptText(t, result)
+		for _, expected := range []string{`"key":"Person"`, "get-graph-stats", "get-neighborhood", "who acted the most"} {
+			if !strings.Contains(text, expected) {
+				t.Errorf("Expected the prompt to contain %q, got:\n%s", expected, text)
+			}
+		}
+	})
+
+	t.Run("focused on a label", func(t *testing.T) {
+		handler := prompts.ExploreGraphHandler(schemaDeps(ctrl), 100, nil)
+		result, err := handler(context.Background(), getPromptRequest(map[string]string{"label": "Movie"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if text := promptText(t, result); !strings.Contains(text, "Sample a few Movie nodes") {
+			t.Errorf("Expected the prompt to focus on Movie, got:\n%s", text)
+		}
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		handler := prompts.ExploreGraphHandler(schemaDeps(ctrl), 100, nil)
+		_, err := handler(context.Background(), getPromptRequest(map[string]string{"label": "Studio"}))
+		if err == nil || !strings.Contains(err.Error(), `label "Studio" not found`) {
+			t.Errorf("Expected a label not found error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+// Package prompts provides the MCP prompts of the server, curated instructions for Cypher authoring
+// and graph exploration embedding the live schema of the database.
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+// databaseArgument is shared by the prompts reading the schema.
+var databaseArgument = mcp.WithArgument("database",
+	mcp.ArgumentDescription("The database to read the schema from, defaults to the configured database"),
+)
+
+// schemaSection reads the schema with the get-schema pipeline and renders it for a prompt.
+func schemaSection(ctx context.Context, deps *tools.ToolDependencies, schemaSampleSize int32, cache *cypher.SchemaCache, database string, rich bool, filter cypher.SchemaFilter) (string, error) {
+	if deps.DBService == nil {
+		return "", errors.New("database service is not initialized")
+	}
+	ctx, err := deps.WithTargetDatabase(ctx, database)
+	if err != nil {
+		return "", err
+	}
+	schema, err := cypher.FetchSchema(ctx, deps, schemaSampleSize, cache, rich, filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve the schema: %w", err)
+	}
+	if len(schema) == 0 {
+		return "The database contains no data, so no schema could be inferred.", nil
+	}
+	jsonData, err := json.Marshal(schema)
+	if err != nil {
+		return "", err
+	}
+	return "The schema of the database, as returned by the get-schema tool:\n```json\n" + string(jsonData) + "\n```", nil
+}
+
+// requiredArgument returns a prompt argument, prompts arguments are not validated by the MCP server.
+func requiredArgument(request mcp.GetPromptRequest, name string) (string, error) {
+	value := strings.TrimSpace(request.Params.Arguments[name])
+	if value == "" {
+		return "", fmt.Errorf("the %s argument is required", name)
+	}
+	return value, nil
+}
+
+// listArgument splits a comma separated prompt argument, prompt arguments are always strings.
+func listArgument(request mcp.GetPromptRequest, name string) []string {
+	var values []string
+	for value := range strings.SplitSeq(request.Params.Arguments[name], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func userMessages(texts ...string) []mcp.PromptMessage {
+	messages := make([]mcp.PromptMessage, 0, len(texts))
+	for _, text := range texts {
+		messages = append(messages, mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)))
+	}
+	return messages
+}
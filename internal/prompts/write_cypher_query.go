@@ -0,0 +1,57 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+const cypherBestPractices = `Follow these Cypher best practices:
+- Only use the labels, relationship types and properties of the schema, with the relationship directions it shows.
+- Pass values as parameters ($name) instead of literals, and give the parameters with the query.
+- Use MERGE on a key property, with ON CREATE SET and ON MATCH SET, to create or update data without duplicates.
+- Bound variable-length patterns, e.g. [:KNOWS*1..3], and LIMIT the returned rows.
+- Avoid cartesian products between disconnected patterns, chain the query parts with WITH and use OPTIONAL MATCH for optional data.
+- Return only the properties needed rather than whole nodes when the result is large.
+- Check the query with explain-cypher, then run it with read-cypher, or write-cypher when it modifies data.`
+
+func WriteCypherQuerySpec() mcp.Prompt {
+	return mcp.NewPrompt("write-cypher-query",
+		mcp.WithPromptTitle("Write a Cypher query"),
+		mcp.WithPromptDescription("Write a Cypher query for a task, using the live schema of the database and Cypher best practices."),
+		mcp.WithArgument("task",
+			mcp.ArgumentDescription("What the query must do, in natural language"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("labels",
+			mcp.ArgumentDescription("Comma separated node labels to scope the schema to, defaults to the whole schema"),
+		),
+		databaseArgument,
+	)
+}
+
+func WriteCypherQueryHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *cypher.SchemaCache) func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		task, err := requiredArgument(request, "task")
+		if err != nil {
+			return nil, err
+		}
+		filter := cypher.SchemaFilter{Labels: listArgument(request, "labels")}
+		schema, err := schemaSection(ctx, deps, schemaSampleSize, cache, request.Params.Arguments["database"], false, filter)
+		if err != nil {
+			slog.Error("failed to build the write-cypher-query prompt", "error", err)
+			return nil, err
+		}
+		return mcp.NewGetPromptResult("Write a Cypher query", userMessages(
+			schema,
+			cypherBestPractices,
+			"Write a Cypher query for the following task, explain it briefly and list its parameters:\n"+task,
+		)), nil
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package prompts_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/prompts"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func schemaRecords() []*neo4j.Record {
+	return []*neo4j.Record{
+		{Keys: []string{"key", "value"}, Values: []any{"Person", map[string]any{
+			"type":       "node",
+			"properties": map[string]any{"name": map[string]any{"type": "STRING", "unique": true}},
+			"relationships": map[string]any{
+				"ACTED_IN": map[string]any{"direction": "out", "labels": []any{"Movie"}, "properties": map[string]any{}},
+			},
+		}}},
+		{Keys: []string{"key", "value"}, Values: []any{"Movie", map[string]any{
+			"type":       "node",
+			"properties": map[string]any{"title": map[string]any{"type": "STRING"}},
+			"relationships": map[string]any{
+				"ACTED_IN": map[string]any{"direction": "in", "labels": []any{"Person"}, "properties": map[string]any{}},
+			},
+		}}},
+		{Keys: []string{"key", "value"}, Values: []any{"ACTED_IN", map[string]any{"type": "relationship", "properties": map[string]any{}}}},
+	}
+}
+
+// schemaDeps returns dependencies whose database returns the test schema once.
+func schemaDeps(ctrl *gomock.Controller) *tools.ToolDependencies {
+	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().
+		ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Eq(map[string]any{"sampleSize": int32(100)})).
+		Return(schemaRecords(), nil)
+	return &tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}
+}
+
+func getPromptRequest(arguments map[string]string) mcp.GetPromptRequest {
+	return mcp.GetPromptRequest{Params: mcp.GetPromptParams{Arguments: arguments}}
+}
+
+// promptText joins the text of the prompt messages.
+func promptText(t *testing.T, result *mcp.GetPromptResult) string {
+	t.Helper()
+	texts := make([]string, 0, len(result.Messages))
+	for _, message := range result.Messages {
+		if message.Role != mcp.RoleUser {
+			t.Errorf("Expected user messages, got %q", message.Role)
+		}
+		textContent, ok := message.Content.(mcp.TextContent)
+		if !ok {
+			t.Fatalf("Expected text content, got %T", message.Content)
+		}
+		texts = append(texts, textContent.Text)
+	}
+	return strings.Join(texts, "\n")
+}
+
+func TestWriteCypherQueryPrompt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("embeds the schema, best practices and task", func(t *testing.T) {
+		handler := prompts.WriteCypherQueryHandler(schemaDeps(ctrl), 100, nil)
+		result, err := handler(context.Background(), getPromptRequest(map[string]string{"task": "Find the movies of Keanu Reeves"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		text := promptText(t, result)
+		for _, expected := range []string{`"key":"Person"`, `"key":"Movie"`, "best practices", "Find the movies of Keanu Reeves"} {
+			if !strings.Contains(text, expected) {
+				t.Errorf("Expected the prompt to contain %q, got:\n%s", expected, text)
+			}
+		}
+		if strings.Contains(text, `"unique"`) {
+			t.Errorf("Expected the compact schema, got:\n%s", text)
+		}
+	})
+
+	t.Run("scopes the schema to labels", func(t *testing.T) {
+		handler := prompts.WriteCypherQueryHandler(schemaDeps(ctrl), 100, nil)
+		result, err := handler(context.Background(), getPromptRequest(map[string]string{"task": "List people", "labels": "Person"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if text := promptText(t, result); strings.Contains(text, `"key":"Movie"`) {
+			t.Errorf("Expected the schema scoped to Person, got:\n%s", text)
+		}
+	})
+
+	t.Run("requires a task", func(t *testing.T) {
+		handler := prompts.WriteCypherQueryHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl)}, 100, nil)
+		if _, err := handler(context.Background(), getPromptRequest(nil)); err == nil || !strings.Contains(err.Error(), "task") {
+			t.Errorf("Expected a missing task error, got: %v", err)
+		}
+	})
+
+	t.Run("rejects a database not allowed", func(t *testing.T) {
+		handler := prompts.WriteCypherQueryHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl)}, 100, nil)
+		_, err := handler(context.Background(), getPromptRequest(map[string]string{"task": "List people", "database": "system"}))
+		if err == nil || !strings.Contains(err.Error(), "not allowed") {
+			t.Errorf("Expected a database not allowed error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/analytics"
+)
+
+// capabilityRemovalProbes is how many consecutive probes must miss a feature in HTTP mode before its tools are removed.
+const capabilityRemovalProbes = 3
+
+// capabilities are the optional features of the Neo4j instance deciding which tools are registered
+// and how their handlers behave. They are probed at startup, or on the first initialize request in HTTP mode,
+// and again every CapabilityRefreshInterval so plugin installs and upgrades apply without a restart.
+type capabilities struct {
+	apoc          bool // apoc.meta.schema is available
+	gds           bool
+	vectorIndexes bool // the Neo4j version supports vector indexes, assumed when it is unknown
+	enterprise    bool
+	// connInfo describes the instance for the connection initialized event, nil when dbms.components() failed
+	connInfo *analytics.ConnectionEventInfo
+}
+
+// defaultCapabilities are assumed before the first probe: the optional plugins are missing,
+// the tools depending on the Neo4j version are available.
+func defaultCapabilities() capabilities {
+	return capabilities{vectorIndexes: true}
+}
+
+// probeCapabilities checks the Neo4j requirements and probes the optional features:
+// - A valid connection with a Neo4j instance.
+// - The ability to perform a read query (database name is correctly defined).
+// - Optional plugin APOC (specifically apoc.meta.schema), without it get-schema infers the schema with the core db.schema procedures
+// - Optional plugin GDS, the GDS tools are registered only when it is installed
+// - The version and edition, vector-search is registered only when the version supports vector indexes
+func (s *Neo4jMCPServer) probeCapabilities(ctx context.Context) (capabilities, error) {
+	caps := defaultCapabilities()
+	// Use a timeout to fail fast if the Neo4j instance is unreachable (e.g., TCP connection refused,
+	// DNS failure, network failure). Without this, ExecuteReadQuery can block for minutes waiting for
+	// the driver's internal connection pool timeout.
+	verifyCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	// Perform a dummy query to verify correctness of the connection.
+	records, err := s.dbService.ExecuteReadQuery(verifyCtx, "RETURN 1 as first", map[string]any{})
+
+	if err != nil {
+		return caps, fmt.Errorf("impossible to verify connectivity with the Neo4j instance: %w", err)
+	}
+	if len(records) != 1 || len(records[0].Values) != 1 {
+		return caps, fmt.Errorf("failed to verify connectivity with the Neo4j instance: unexpected response from test query")
+	}
+	one, ok := records[0].Values[0].(int64)
+	if !ok || one != 1 {
+		return caps, fmt.Errorf("failed to verify connectivity with the Neo4j instance: unexpected response from test query")
+	}
+	// Check for apoc.meta.schema procedure
+	checkApocMetaSchemaQuery := "SHOW PROCEDURES YIELD name WHERE name = 'apoc.meta.schema' RETURN count(name) > 0 AS apocMetaSchemaAvailable"
+
+	// APOC is optional, without apoc.meta.schema get-schema uses the core schema procedures.
+	records, err = s.dbService.ExecuteReadQuery(ctx, checkApocMetaSchemaQuery, nil)
+	switch {
+	case err != nil:
+		slog.Debug("Impossible to verify APOC installation", "error", err)
+	case len(records) != 1 || len(records[0].Values) != 1:
+		slog.Debug("Failed to verify APOC installation: unexpected response from test query")
+	default:
+		caps.apoc, _ = records[0].Values[0].(bool)
+	}
+	// Call gds.version procedure to determine if GDS is installed
+	records, err = s.dbService.ExecuteReadQuery(ctx, "RETURN gds.version() as gdsVersion", nil)
+	if err != nil {
+		// GDS is optional, so we continue, assuming it's not installed.
+		slog.Debug("Impossible to verify GDS installation", "error", err)
+	} else if len(records) == 1 && len(records[0].Values) == 1 {
+		_, caps.gds = records[0].Values[0].(string)
+	}
+	// The version and edition are optional as well, the tools depending on them stay available when unknown
+	records, err = s.dbService.ExecuteReadQuery(ctx, "CALL dbms.components()", map[string]any{})
+	if err != nil {
+		slog.Debug("Failed to collect connection metadata", "error", err.Error())
+		return caps, nil
+	}
+	connInfo := recordsToConnectionEventInfo(records)
+	caps.connInfo = &connInfo
+	caps.vectorIndexes = supportsVectorIndexes(connInfo.Neo4jVersion)
+	caps.enterprise = connInfo.Edition == "enterprise"
+
+	return caps, nil
+}
+
+// supportsVectorIndexes reports whether a Neo4j version has vector indexes, introduced in 5.11.
+// Calendar versions (2025.01 and later) have them, unknown versions are assumed to.
+func supportsVectorIndexes(version string) bool {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return true
+	}
+	return major > 5 || (major == 5 && minor >= 11)
+}
+
+// refreshCapabilities probes the capabilities again and updates the tools whose availability or handler
+// depends on a changed capability. Adding or removing tools notifies the clients with notifications/tools/list_changed.
+// In HTTP mode the probe runs with the credentials of the initializing client, which may not be allowed to use
+// the plugins other users can: the features found by one of the last capabilityRemovalProbes probes are kept,
+// so the tools are removed only once these probes agree the feature is gone, e.g. after an uninstall or a downgrade.
+// On error the capabilities and the tools are left unchanged.
+func (s *Neo4jMCPServer) refreshCapabilities(ctx context.Context) error {
+	s.capabilitiesMu.Lock()
+	defer s.capabilitiesMu.Unlock()
+
+	caps, err := s.probeCapabilities(ctx)
+	if err != nil {
+		return err
+	}
+	previous := s.capabilities
+	firstProbe := s.capabilitiesProbedAt.IsZero()
+	s.recentProbes = append(s.recentProbes, caps)
+	if len(s.recentProbes) > capabilityRemovalProbes {
+		s.recentProbes = s.recentProbes[1:]
+	}
+	if s.config.TransportMode.IsHTTP() {
+		for _, probe := range s.recentProbes {
+			caps = caps.union(probe)
+		}
+	}
+	s.capabilities = caps
+	s.capabilitiesProbedAt = time.Now()
+	switch {
+	case firstProbe:
+		caps.log("Neo4j capabilities probed")
+	case previous.sameFeatures(caps):
+		return nil
+	default:
+		caps.log("Neo4j capabilities changed, updating the tools")
+	}
+	s.applyCapabilities(previous)
+	return nil
+}
+
+// log logs the probed features, and warns about the missing plugins.
+func (c capabilities) log(message string) {
+	slog.Info(message, "apoc", c.apoc, "gds", c.gds, "vectorIndexes", c.vectorIndexes, "enterprise", c.enterprise)
+	if !c.apoc {
+		slog.Warn("apoc.meta.schema is not available, get-schema will use the core schema procedures. Install the APOC plugin including the 'meta' component for a richer schema")
+	}
+	if !c.gds {
+		slog.Warn("GDS is not installed, the GDS tools are not registered")
+	}
+	if !c.vectorIndexes {
+		slog.Warn("The Neo4j version does not support vector indexes, vector-search is not registered")
+	}
+}
+
+// sameFeatures reports whether both probes found the same features.
+func (c capabilities) sameFeatures(other capabilities) bool {
+	return c.apoc == other.apoc && c.gds == other.gds && c.vectorIndexes == other.vectorIndexes && c.enterprise == other.enterprise
+}
+
+// union returns the features found by either probe, the connection metadata of c when it was collected.
+func (c capabilities) union(other capabilities) capabilities {
+	c.apoc = c.apoc || other.apoc
+	c.gds = c.gds || other.gds
+	c.vectorIndexes = c.vectorIndexes || other.vectorIndexes
+	c.enterprise = c.enterprise || other.enterprise
+	if c.connInfo == nil {
+		c.connInfo = other.connInfo
+	}
+	return c
+}
+
+// applyCapabilities registers the tools enabled by the current capabilities and not by the previous ones,
+// registers again the tools using a changed capability, and removes the tools it disables.
+func (s *Neo4jMCPServer) applyCapabilities(previous capabilities) {
+	current := s.capabilities
+	deps := s.newToolDependencies()
+	toolDefs := s.getAllToolsDefs(deps)
+
+	wasEnabled := make(map[string]bool)
+	for _, toolDef := range s.filterTools(toolDefs, previous) {
+		wasEnabled[toolDef.definition.Tool.Name] = true
+	}
+	enabled := make(map[string]bool)
+	added := make([]server.ServerTool, 0)
+	for _, toolDef := range s.filterTools(toolDefs, current) {
+		name := toolDef.definition.Tool.Name
+		enabled[name] = true
+		if !wasEnabled[name] || (toolDef.usesGDS && previous.gds != current.gds) || (toolDef.usesAPOC && previous.apoc != current.apoc) {
+			added = append(added, toolDef.definition)
+		}
+	}
+	removed := make([]string, 0)
+	for name := range wasEnabled {
+		if !enabled[name] {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(removed) > 0 {
+		slices.Sort(removed)
+		slog.Info("Removing the tools disabled by the Neo4j capabilities", "tools", removed)
+		s.MCPServer.DeleteTools(removed...)
+	}
+	if len(added) > 0 {
+		s.MCPServer.AddTools(added...)
+	}
+	if previous.apoc != current.apoc {
+		s.registerResources()
+		s.registerPrompts()
+	}
+}
+
+// capabilitiesStale reports whether the last probe is older than CapabilityRefreshInterval.
+func (s *Neo4jMCPServer) capabilitiesStale() bool {
+	interval := s.config.CapabilityRefreshInterval
+	if interval <= 0 {
+		return false
+	}
+	s.capabilitiesMu.Lock()
+	defer s.capabilitiesMu.Unlock()
+	return time.Since(s.capabilitiesProbedAt) >= interval
+}
+
+// watchCapabilities probes the capabilities again every CapabilityRefreshInterval and whenever the process
+// receives SIGHUP, until the context is done. It is used in STDIO mode, where the credentials are known.
+func (s *Neo4jMCPServer) watchCapabilities(ctx context.Context) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	var tick <-chan time.Time
+	if s.config.CapabilityRefreshInterval > 0 {
+		ticker := time.NewTicker(s.config.CapabilityRefreshInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		case <-hangup:
+			slog.Info("SIGHUP received, probing the Neo4j capabilities again")
+		}
+		if err := s.refreshCapabilities(ctx); err != nil {
+			slog.Warn("Failed to probe the Neo4j capabilities, the tools are unchanged", "error", err)
+		}
+	}
+}
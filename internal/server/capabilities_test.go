@@ -0,0 +1,211 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics_mocks "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db_mocks "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// expectProbe mocks one capabilities probe of a Neo4j instance with the given plugins and version.
+func expectProbe(mockDB *db_mocks.MockService, apoc, gds bool, version string) {
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "RETURN 1 as first", gomock.Any()).Times(1).Return([]*neo4j.Record{
+		{Keys: []string{"first"}, Values: []any{int64(1)}},
+	}, nil)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "SHOW PROCEDURES YIELD name WHERE name = 'apoc.meta.schema' RETURN count(name) > 0 AS apocMetaSchemaAvailable", gomock.Any()).Times(1).Return([]*neo4j.Record{
+		{Keys: []string{"apocMetaSchemaAvailable"}, Values: []any{apoc}},
+	}, nil)
+	gdsCall := mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "RETURN gds.version() as gdsVersion", gomock.Any()).Times(1)
+	if gds {
+		gdsCall.Return([]*neo4j.Record{{Keys: []string{"gdsVersion"}, Values: []any{"2.22.0"}}}, nil)
+	} else {
+		gdsCall.Return(nil, fmt.Errorf("Unknown function 'gds.version'"))
+	}
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "CALL dbms.components()", gomock.Any()).Times(1).Return([]*neo4j.Record{
+		{Keys: []string{"name", "edition", "versions"}, Values: []any{"Neo4j Kernel", "enterprise", []any{version}}},
+	}, nil)
+}
+
+// newCapabilitiesTestServer returns a started STDIO server with a registered session, without serving STDIO.
+func newCapabilitiesTestServer(t *testing.T, mockDB *db_mocks.MockService) (*Neo4jMCPServer, *loggingSession) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	cfg := &config.Config{Database: "neo4j", TransportMode: config.TransportModeStdio}
+	s := NewNeo4jMCPServer("test-version", cfg, mockDB, analytics_mocks.NewMockService(ctrl))
+	if err := s.verifyRequirements(context.Background()); err != nil {
+		t.Fatalf("verifyRequirements() unexpected error: %v", err)
+	}
+	if err := s.registerTools(); err != nil {
+		t.Fatalf("registerTools() unexpected error: %v", err)
+	}
+	session := &loggingSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	if err := s.MCPServer.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("failed to register the session: %v", err)
+	}
+	return s, session
+}
+
+func countListChanged(session *loggingSession) int {
+	count := 0
+	for {
+		select {
+		case notification := <-session.notifications:
+			if notification.Method == mcp.MethodNotificationToolsListChanged {
+				count++
+			}
+		default:
+			return count
+		}
+	}
+}
+
+func TestRefreshCapabilities(t *testing.T) {
+	t.Run("adds and removes the tools following the probed capabilities", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectProbe(mockDB, false, false, "5.26.0")
+		expectProbe(mockDB, true, true, "5.10.0")
+		s, session := newCapabilitiesTestServer(t, mockDB)
+
+		tools := s.MCPServer.ListTools()
+		if _, ok := tools["list-gds-procedures"]; ok {
+			t.Fatal("expected list-gds-procedures to be registered only with GDS")
+		}
+		if _, ok := tools["vector-search"]; !ok {
+			t.Fatal("expected vector-search to be registered with Neo4j 5.26")
+		}
+
+		if err := s.refreshCapabilities(context.Background()); err != nil {
+			t.Fatalf("refreshCapabilities() unexpected error: %v", err)
+		}
+
+		tools = s.MCPServer.ListTools()
+		if _, ok := tools["list-gds-procedures"]; !ok {
+			t.Error("expected list-gds-procedures to be registered once GDS is installed")
+		}
+		if _, ok := tools["vector-search"]; ok {
+			t.Error("expected vector-search to be removed with Neo4j 5.10")
+		}
+		if count := countListChanged(session); count == 0 {
+			t.Error("expected the client to be notified with notifications/tools/list_changed")
+		}
+		if !s.capabilities.apoc || !s.capabilities.gds || !s.capabilities.enterprise {
+			t.Errorf("unexpected capabilities: %+v", s.capabilities)
+		}
+	})
+
+	t.Run("does not notify the clients when the capabilities are unchanged", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectProbe(mockDB, true, true, "2025.01.0")
+		expectProbe(mockDB, true, true, "2025.02.0")
+		s, session := newCapabilitiesTestServer(t, mockDB)
+		toolsCount := len(s.MCPServer.ListTools())
+
+		if err := s.refreshCapabilities(context.Background()); err != nil {
+			t.Fatalf("refreshCapabilities() unexpected error: %v", err)
+		}
+
+		if count := countListChanged(session); count != 0 {
+			t.Errorf("expected no notification, got %d", count)
+		}
+		if len(s.MCPServer.ListTools()) != toolsCount {
+			t.Errorf("expected %d tools, got %d", toolsCount, len(s.MCPServer.ListTools()))
+		}
+	})
+
+	t.Run("only adds the tools in HTTP mode", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectProbe(mockDB, true, false, "5.26.0")
+		// A client not allowed to use APOC initializes, and GDS was installed
+		expectProbe(mockDB, false, true, "5.26.0")
+		s, _ := newCapabilitiesTestServer(t, mockDB)
+		s.config.TransportMode = config.TransportModeHTTP
+
+		if err := s.refreshCapabilities(context.Background()); err != nil {
+			t.Fatalf("refreshCapabilities() unexpected error: %v", err)
+		}
+
+		if !s.capabilities.apoc || !s.capabilities.gds {
+			t.Errorf("expected APOC to be kept and GDS to be added, got %+v", s.capabilities)
+		}
+		if _, ok := s.MCPServer.ListTools()["list-gds-procedures"]; !ok {
+			t.Error("expected list-gds-procedures to be registered once GDS is installed")
+		}
+	})
+
+	t.Run("removes the tools in HTTP mode once the recent probes agree", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectProbe(mockDB, true, true, "5.26.0")
+		// GDS was uninstalled
+		for range capabilityRemovalProbes {
+			expectProbe(mockDB, true, false, "5.26.0")
+		}
+		s, session := newCapabilitiesTestServer(t, mockDB)
+		s.config.TransportMode = config.TransportModeHTTP
+
+		for i := range capabilityRemovalProbes {
+			if err := s.refreshCapabilities(context.Background()); err != nil {
+				t.Fatalf("refreshCapabilities() unexpected error: %v", err)
+			}
+			if removed := i == capabilityRemovalProbes-1; s.capabilities.gds == removed {
+				t.Fatalf("after %d probes without GDS, expected GDS to be removed: %v, got %+v", i+1, removed, s.capabilities)
+			}
+		}
+
+		if _, ok := s.MCPServer.ListTools()["list-gds-procedures"]; ok {
+			t.Error("expected list-gds-procedures to be removed once GDS is uninstalled")
+		}
+		if count := countListChanged(session); count == 0 {
+			t.Error("expected the client to be notified with notifications/tools/list_changed")
+		}
+	})
+
+	t.Run("keeps the tools when the probe fails", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectProbe(mockDB, true, true, "5.26.0")
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "RETURN 1 as first", gomock.Any()).Times(1).Return(nil, fmt.Errorf("connection error"))
+		s, session := newCapabilitiesTestServer(t, mockDB)
+		toolsCount := len(s.MCPServer.ListTools())
+
+		if err := s.refreshCapabilities(context.Background()); err == nil {
+			t.Fatal("refreshCapabilities() expected an error")
+		}
+
+		if count := countListChanged(session); count != 0 {
+			t.Errorf("expected no notification, got %d", count)
+		}
+		if len(s.MCPServer.ListTools()) != toolsCount || !s.capabilities.gds {
+			t.Errorf("expected the tools and the capabilities to be unchanged, got %d tools and %+v", len(s.MCPServer.ListTools()), s.capabilities)
+		}
+	})
+}
+
+func TestSupportsVectorIndexes(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{version: "4.4.30", expected: false},
+		{version: "5.10.0", expected: false},
+		{version: "5.11.0", expected: true},
+		{version: "5.26.2", expected: true},
+		{version: "2025.01.0", expected: true},
+		{version: "unknown", expected: true},
+		{version: "", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := supportsVectorIndexes(tt.version); got != tt.expected {
+				t.Errorf("supportsVectorIndexes(%q) = %v, want %v", tt.version, got, tt.expected)
+			}
+		})
+	}
+}
@@ -39,7 +39,7 @@ func mockNeo4jMCPServer(t *testing.T) *Neo4jMCPServer {
 		dbService:    mockDBService,
 		anService:    mockAnalyticsService,
 		version:      "1.0.0",
-		gdsInstalled: false,
+		capabilities: defaultCapabilities(),
 	}
 }
 
@@ -0,0 +1,65 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/logger"
+)
+
+// logNotifier forwards the server logs as notifications/message to the registered sessions,
+// at the level each client selected with logging/setLevel.
+// It implements logger.Notifier, so it must not log.
+type logNotifier struct {
+	srv      *server.MCPServer
+	sessions sync.Map // session id -> server.SessionWithLogging
+}
+
+var _ logger.Notifier = (*logNotifier)(nil)
+
+func newLogNotifier() *logNotifier {
+	return &logNotifier{}
+}
+
+// register is a server.OnRegisterSessionHookFunc tracking the sessions supporting logging.
+func (n *logNotifier) register(_ context.Context, session server.ClientSession) {
+	if sessionLogging, ok := session.(server.SessionWithLogging); ok {
+		n.sessions.Store(session.SessionID(), sessionLogging)
+	}
+}
+
+// unregister is a server.OnUnregisterSessionHookFunc.
+func (n *logNotifier) unregister(_ context.Context, session server.ClientSession) {
+	n.sessions.Delete(session.SessionID())
+}
+
+// Enabled reports whether an initialized session wants the records of the level.
+func (n *logNotifier) Enabled(level slog.Level) bool {
+	if n.srv == nil {
+		return false
+	}
+	mcpLevel := mcp.LoggingLevel(logger.LevelName(level))
+	enabled := false
+	n.sessions.Range(func(_, value any) bool {
+		session := value.(server.SessionWithLogging)
+		enabled = session.Initialized() && mcpLevel.ShouldSendTo(session.GetLogLevel())
+		return !enabled
+	})
+	return enabled
+}
+
+// Notify sends the record to the sessions, the server filters them by their level.
+func (n *logNotifier) Notify(level string, data map[string]any) {
+	notification := mcp.NewLoggingMessageNotification(mcp.LoggingLevel(level), "neo4j-mcp", data)
+	n.sessions.Range(func(key, _ any) bool {
+		// Errors are not logged, it would notify the same sessions again
+		_ = n.srv.SendLogMessageToSpecificClient(key.(string), notification)
+		return true
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics_mocks "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db_mocks "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/logger"
+	"go.uber.org/mock/gomock"
+)
+
+// loggingSession is an initialized client session supporting logging/setLevel.
+type loggingSession struct {
+	notifications chan mcp.JSONRPCNotification
+	level         mcp.LoggingLevel
+}
+
+func (s *loggingSession) Initialize()                                         {}
+func (s *loggingSession) Initialized() bool                                   { return true }
+func (s *loggingSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return s.notifications }
+func (s *loggingSession) SessionID() string                                   { return "logging-test" }
+func (s *loggingSession) SetLogLevel(level mcp.LoggingLevel)                  { s.level = level }
+func (s *loggingSession) GetLogLevel() mcp.LoggingLevel                       { return s.level }
+
+func newLoggingTestServer(t *testing.T, transportMode config.TransportMode) *Neo4jMCPServer {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	cfg := &config.Config{Database: "neo4j", TransportMode: transportMode}
+	return NewNeo4jMCPServer("test-version", cfg, db_mocks.NewMockService(ctrl), analytics_mocks.NewMockService(ctrl))
+}
+
+func TestLogNotifier(t *testing.T) {
+	t.Run("forwards the logs at the level set by the client in stdio mode", func(t *testing.T) {
+		s := newLoggingTestServer(t, config.TransportModeStdio)
+		session := &loggingSession{notifications: make(chan mcp.JSONRPCNotification, 10), level: mcp.LoggingLevelError}
+		if err := s.MCPServer.RegisterSession(context.Background(), session); err != nil {
+			t.Fatalf("failed to register the session: %v", err)
+		}
+
+		message := `{"jsonrpc":"2.0","id":1,"method":"logging/setLevel","params":{"level":"warning"}}`
+		response := s.MCPServer.HandleMessage(s.MCPServer.WithContext(context.Background(), session), json.RawMessage(message))
+		if _, ok := response.(mcp.JSONRPCResponse); !ok {
+			t.Fatalf("expected a successful logging/setLevel response, got %+v", response)
+		}
+
+		log := logger.New("error", "text", io.Discard)
+		log.SetNotifier(s.logNotifier)
+		log.Info("not forwarded")
+		log.Warn("slow query", "query", "MATCH (n) RETURN n", "password", "secret")
+
+		if len(session.notifications) != 1 {
+			t.Fatalf("expected 1 notification, got %d", len(session.notifications))
+		}
+		notification := <-session.notifications
+		if notification.Method != "notifications/message" {
+			t.Errorf("expected notifications/message, got %s", notification.Method)
+		}
+		fields := notification.Params.AdditionalFields
+		data, _ := fields["data"].(map[string]any)
+		if fields["level"] != mcp.LoggingLevelWarning || fields["logger"] != "neo4j-mcp" {
+			t.Errorf("unexpected notification params: %v", fields)
+		}
+		if data["message"] != "slow query" || data["query"] != "MATCH (n) RETURN n" || data["password"] != "[REDACTED]" {
+			t.Errorf("unexpected notification data: %v", data)
+		}
+
+		s.MCPServer.UnregisterSession(context.Background(), session.SessionID())
+		if s.logNotifier.Enabled(logger.LevelEmergency) {
+			t.Error("expected no record to be forwarded once the session is unregistered")
+		}
+	})
+
+	t.Run("logging is not enabled in HTTP mode", func(t *testing.T) {
+		s := newLoggingTestServer(t, config.TransportModeHTTP)
+		if s.logNotifier != nil {
+			t.Error("expected no log notifier in HTTP mode")
+		}
+
+		message := `{"jsonrpc":"2.0","id":1,"method":"logging/setLevel","params":{"level":"debug"}}`
+		response := s.MCPServer.HandleMessage(context.Background(), json.RawMessage(message))
+		if _, ok := response.(mcp.JSONRPCError); !ok {
+			t.Errorf("expected logging/setLevel to fail in HTTP mode, got %+v", response)
+		}
+	})
+}
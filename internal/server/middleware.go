@@ -14,11 +14,15 @@ import (
 	"strings"
 
 	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
 )
 
 const (
 	corsMaxAgeSeconds           = "86400" // 24 hours
 	maxUnauthenticatedBodyBytes = 4 * 1024
+	databaseHeaderName          = "X-Neo4j-Database"
+	sessionIDHeaderName         = "Mcp-Session-Id"
 )
 
 var errRequestBodyTooLarge = errors.New("request body too large")
@@ -30,7 +34,7 @@ func (s *Neo4jMCPServer) chainMiddleware(allowedOrigins []string, next http.Hand
 	}
 
 	// Chain middleware in reverse order (last added = first to execute)
-	// Execution order: PathValidator -> CORS -> Auth (Bearer/Basic) -> Logging -> Handler
+	// Execution order: PathValidator -> CORS -> Auth (Bearer/Basic) -> SSE session owner -> Database -> Logging -> Handler
 
 	// Start with the actual handler
 	handler := next
@@ -38,6 +42,14 @@ func (s *Neo4jMCPServer) chainMiddleware(allowedOrigins []string, next http.Hand
 	// Add logging middleware
 	handler = loggingMiddleware()(handler)
 
+	// Add database selection middleware (X-Neo4j-Database header)
+	handler = databaseMiddleware(s.config.TargetableDatabases())(handler)
+
+	// In SSE mode, the messages of a session are only accepted with the credentials that opened its stream
+	if s.sseSessions != nil {
+		handler = s.sseSessions.sessionOwnerMiddleware(handler)
+	}
+
 	var unauthMethods []string
 	if s.config.AllowUnauthenticatedPing {
 		unauthMethods = append(unauthMethods, "ping")
@@ -47,11 +59,24 @@ func (s *Neo4jMCPServer) chainMiddleware(allowedOrigins []string, next http.Hand
 	}
 	handler = authMiddleware(s.config.AuthHeaderName, unauthMethods)(handler)
 
+	// Stateful sessions are terminated with DELETE, the SSE streams are opened with GET
+	var sessionMethods []string
+	if s.sessions != nil {
+		sessionMethods = append(sessionMethods, http.MethodDelete)
+	}
+	if s.config.TransportMode == config.TransportModeSSE {
+		sessionMethods = append(sessionMethods, http.MethodGet)
+	}
+
 	// Add CORS middleware (if configured) - includes Mcp-Session-Id in allowed headers
-	handler = corsMiddleware(allowedOrigins, s.config.AuthHeaderName)(handler)
+	handler = corsMiddleware(allowedOrigins, s.config.AuthHeaderName, sessionMethods...)(handler)
 
-	// Add path validation middleware last (executes first - reject non-/mcp paths quickly)
-	handler = pathValidationMiddleware()(handler)
+	// Add path validation middleware last (executes first - reject non-/mcp paths quickly, /sse and /message in SSE mode)
+	if s.config.TransportMode == config.TransportModeSSE {
+		handler = ssePathValidationMiddleware()(handler)
+	} else {
+		handler = pathValidationMiddleware(sessionMethods...)(handler)
+	}
 
 	return handler
 }
@@ -136,11 +161,34 @@ func authMiddleware(headerName string, unauthenticatedMethods []string) func(htt
 	}
 }
 
+// databaseMiddleware selects the database targeted by the request from the X-Neo4j-Database header.
+// The database is stored in the request context and used by tools unless a tool call specifies its own database.
+// Returns 403 Forbidden if the requested database is not in the allowed databases.
+func databaseMiddleware(allowedDatabases []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			name := strings.TrimSpace(r.Header.Get(databaseHeaderName))
+			if name == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !config.IsDatabaseAllowed(allowedDatabases, name) {
+				http.Error(w, "Forbidden: database is not allowed", http.StatusForbidden)
+				return
+			}
+			ctx := database.WithTargetDatabase(r.Context(), name)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
 // corsMiddleware implements CORS (Cross-Origin Resource Sharing)
 // If allowedOrigins is empty, CORS is disabled
 // If allowedOrigins is "*", all origins are allowed
 // Otherwise, allowedOrigins should be a comma-separated list of allowed origins
-func corsMiddleware(allowedOrigins []string, authHeaderName string) func(http.Handler) http.Handler {
+// extraMethods are allowed in addition to POST and OPTIONS, e.g. DELETE to terminate a stateful session.
+func corsMiddleware(allowedOrigins []string, authHeaderName string, extraMethods ...string) func(http.Handler) http.Handler {
+	allowedMethods := strings.Join(append([]string{http.MethodPost, http.MethodOptions}, extraMethods...), ", ")
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Skip CORS if not configured
@@ -159,15 +207,18 @@ func corsMiddleware(allowedOrigins []string, authHeaderName string) func(http.Ha
 				w.Header().Set("Access-Control-Allow-Origin", origin)
 			}
 
-			// Build allowed headers list, always include Content-Type and Authorization.
-			allowedHeaders := []string{"Content-Type", "Authorization"}
+			// Build allowed headers list, always include Content-Type, Authorization, the database selection header
+			// and the session header, used in stateful mode.
+			allowedHeaders := []string{"Content-Type", "Authorization", databaseHeaderName, sessionIDHeaderName}
 			// If a custom auth header is configured, and it's not the default, include it
 			if authHeaderName != "" && !strings.EqualFold(authHeaderName, "Authorization") {
 				allowedHeaders = append(allowedHeaders, authHeaderName)
 			}
 
-			w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
 			w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+			// Browsers hide the response headers not exposed, the client reads the session id issued on initialize
+			w.Header().Set("Access-Control-Expose-Headers", sessionIDHeaderName)
 			w.Header().Set("Access-Control-Max-Age", corsMaxAgeSeconds)
 
 			// Handle preflight requests
@@ -186,7 +237,9 @@ func corsMiddleware(allowedOrigins []string, authHeaderName string) func(http.Ha
 // hanging connections, and 405 for any method other than POST or OPTIONS since
 // the MCP StreamableHTTP Transport spec requires all client messages to be POST
 // requests. OPTIONS is permitted so that CORS preflight continues to work.
-func pathValidationMiddleware() func(http.Handler) http.Handler {
+// extraMethods are permitted as well, e.g. DELETE to terminate a stateful session.
+func pathValidationMiddleware(extraMethods ...string) func(http.Handler) http.Handler {
+	allowedMethods := append([]string{http.MethodPost, http.MethodOptions}, extraMethods...)
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Only /mcp path is valid for this MCP server
@@ -194,9 +247,9 @@ func pathValidationMiddleware() func(http.Handler) http.Handler {
 				http.Error(w, "Not Found: This server only handles requests to /mcp", http.StatusNotFound)
 				return
 			}
-			// Only POST and OPTIONS are supported.
-			if r.Method != http.MethodPost && r.Method != http.MethodOptions {
-				w.Header().Set("Allow", "POST, OPTIONS")
+			// Only POST and OPTIONS are supported, along with extraMethods.
+			if !slices.Contains(allowedMethods, r.Method) {
+				w.Header().Set("Allow", strings.Join(allowedMethods, ", "))
 				http.Error(w, "Method Not Allowed: only POST is supported on /mcp", http.StatusMethodNotAllowed)
 				return
 			}
@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/session"
 )
 
 // authCheckHandler verifies if credentials are in context
@@ -354,7 +356,7 @@ func TestCORSMiddleware_PreflightRequest(t *testing.T) {
 		t.Error("Expected Access-Control-Allow-Methods header to be set")
 	}
 
-	if rec.Header().Get("Access-Control-Allow-Headers") != "Content-Type, Authorization, X-Auth" {
+	if rec.Header().Get("Access-Control-Allow-Headers") != "Content-Type, Authorization, X-Neo4j-Database, Mcp-Session-Id, X-Auth" {
 		t.Error("Expected Access-Control-Allow-Headers header to be set")
 	}
 
@@ -383,6 +385,79 @@ func TestCORSMiddleware_MissingOriginHeader(t *testing.T) {
 	}
 }
 
+// databaseCheckHandler verifies which database is selected in context
+func databaseCheckHandler(t *testing.T, expectDatabase bool, expectedDatabase string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, ok := database.GetTargetDatabase(r.Context())
+		if expectDatabase {
+			if !ok {
+				t.Error("Expected target database in context, but none found")
+			}
+			if name != expectedDatabase {
+				t.Errorf("Expected database %q, got %q", expectedDatabase, name)
+			}
+		} else if ok {
+			t.Errorf("Expected no target database in context, got %q", name)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestDatabaseMiddleware_WithoutHeader(t *testing.T) {
+	handler := databaseMiddleware([]string{"neo4j"})(databaseCheckHandler(t, false, ""))
+
+	req := httptest.NewRequest("POST", "/mcp", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+}
+
+func TestDatabaseMiddleware_WithAllowedDatabase(t *testing.T) {
+	handler := databaseMiddleware([]string{"neo4j", "movies"})(databaseCheckHandler(t, true, "movies"))
+
+	req := httptest.NewRequest("POST", "/mcp", nil)
+	req.Header.Set("X-Neo4j-Database", "movies")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+}
+
+func TestDatabaseMiddleware_WithDisallowedDatabase(t *testing.T) {
+	handler := databaseMiddleware([]string{"neo4j"})(databaseCheckHandler(t, false, ""))
+
+	req := httptest.NewRequest("POST", "/mcp", nil)
+	req.Header.Set("X-Neo4j-Database", "secrets")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", rec.Code)
+	}
+}
+
+func TestDatabaseMiddleware_WithWildcard(t *testing.T) {
+	handler := databaseMiddleware([]string{"neo4j", "*"})(databaseCheckHandler(t, true, "anything"))
+
+	req := httptest.NewRequest("POST", "/mcp", nil)
+	req.Header.Set("X-Neo4j-Database", "anything")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+}
+
 func TestLoggingMiddleware(t *testing.T) {
 	handler := loggingMiddleware()(mockHandler())
 
@@ -470,6 +545,28 @@ func TestPathValidationMiddleware_DisallowedMethodReturns405InFullChain(t *testi
 	}
 }
 
+func TestPathValidationMiddleware_DeleteAllowedForStatefulSessions(t *testing.T) {
+	mockServer := mockNeo4jMCPServer(t)
+	mockServer.sessions = session.NewStore(1, 0)
+	handler := mockServer.chainMiddleware([]string{"*"}, mockHandler())
+
+	req := httptest.NewRequest(http.MethodDelete, "/mcp", nil)
+	req.SetBasicAuth("user", "pass")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for DELETE /mcp in stateful mode, got %d", rec.Code)
+	}
+	if methods := rec.Header().Get("Access-Control-Allow-Methods"); methods != "POST, OPTIONS, DELETE" {
+		t.Errorf("Expected DELETE in Access-Control-Allow-Methods, got %q", methods)
+	}
+	if exposed := rec.Header().Get("Access-Control-Expose-Headers"); exposed != "Mcp-Session-Id" {
+		t.Errorf("Expected Mcp-Session-Id in Access-Control-Expose-Headers, got %q", exposed)
+	}
+}
+
 func TestParseAllowedOrigins_Empty(t *testing.T) {
 	result := parseAllowedOrigins("")
 	if len(result) != 0 {
@@ -692,6 +789,24 @@ func TestAuthMiddleware_BlocksUnauthenticatedToolsListWhenDisabled(t *testing.T)
 	}
 }
 
+func TestAuthMiddleware_BlocksUnauthenticatedResourcesRead(t *testing.T) {
+	mockServer := mockNeo4jMCPServer(t)
+	mockServer.config.AllowUnauthenticatedPing = true
+	mockServer.config.AllowUnauthenticatedToolsList = true
+	handler := mockServer.chainMiddleware([]string{}, mockHandler())
+
+	body := `{"jsonrpc":"2.0","method":"resources/read","params":{"uri":"neo4j://schema"},"id":1}`
+	req := httptest.NewRequest("POST", "/mcp", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected 401 for unauthenticated resources/read, got %d", rec.Code)
+	}
+}
+
 func TestAuthMiddleware_RejectsTooLargeUnauthenticatedPing(t *testing.T) {
 	// This test constructs a POST /mcp request whose body exceeds the
 	// maxUnauthenticatedBodyBytes limit. We set ContentLength to -1 so the
@@ -0,0 +1,35 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/prompts"
+)
+
+// registerPrompts registers the curated prompts. The prompts embedding the schema read it like get-schema,
+// so they are registered again once APOC is detected as for the tools using it.
+func (s *Neo4jMCPServer) registerPrompts() {
+	deps := s.newToolDependencies()
+	sampleSize := s.config.SchemaSampleSize
+
+	s.MCPServer.AddPrompts(
+		server.ServerPrompt{
+			Prompt:  prompts.WriteCypherQuerySpec(),
+			Handler: prompts.WriteCypherQueryHandler(deps, sampleSize, s.schemaCache),
+		},
+		server.ServerPrompt{
+			Prompt:  prompts.ExploreGraphSpec(),
+			Handler: prompts.ExploreGraphHandler(deps, sampleSize, s.schemaCache),
+		},
+		server.ServerPrompt{
+			Prompt:  prompts.ExplainQueryResultsSpec(),
+			Handler: prompts.ExplainQueryResultsHandler(),
+		},
+		server.ServerPrompt{
+			Prompt:  prompts.DataQualityReviewSpec(),
+			Handler: prompts.DataQualityReviewHandler(deps, sampleSize, s.schemaCache),
+		},
+	)
+}
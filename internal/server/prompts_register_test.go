@@ -0,0 +1,46 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server_test
+
+import (
+	"testing"
+
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/server"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPromptRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aService := analytics.NewMockService(ctrl)
+	aService.EXPECT().IsEnabled().AnyTimes().Return(false)
+	aService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	aService.EXPECT().NewStartupEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	cfg := &config.Config{
+		URI:           "bolt://test-host:7687",
+		Username:      "neo4j",
+		Password:      "password",
+		Database:      "neo4j",
+		TransportMode: config.TransportModeStdio,
+	}
+	s := server.NewNeo4jMCPServer("test-version", cfg, getMockedDBService(ctrl, false), aService)
+	if err := s.Start(); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	// update this list when a prompt is added or removed.
+	registeredPrompts := s.MCPServer.ListPrompts()
+	for _, name := range []string{"write-cypher-query", "explore-graph", "explain-query-results", "data-quality-review"} {
+		if _, found := registeredPrompts[name]; !found {
+			t.Errorf("Expected the %s prompt to be registered", name)
+		}
+	}
+	if len(registeredPrompts) != 4 {
+		t.Errorf("Expected 4 prompts, got %d", len(registeredPrompts))
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+// registerResources registers the schema resources, so clients preloading resources get the schema without a tool call.
+// Resources are read with the same request context as tools, in HTTP mode the per-request credentials apply.
+// Registering again replaces the handlers, this is done once APOC is detected as for the tools using it.
+func (s *Neo4jMCPServer) registerResources() {
+	deps := s.newToolDependencies()
+	sampleSize := s.config.SchemaSampleSize
+
+	s.MCPServer.AddResources(server.ServerResource{
+		Resource: cypher.SchemaResourceSpec(),
+		Handler:  cypher.SchemaResourceHandler(deps, sampleSize, s.schemaCache),
+	})
+	s.MCPServer.AddResourceTemplates(
+		server.ServerResourceTemplate{
+			Template: cypher.LabelSchemaResourceSpec(),
+			Handler:  cypher.LabelSchemaResourceHandler(deps, sampleSize, s.schemaCache),
+		},
+		server.ServerResourceTemplate{
+			Template: cypher.RelationshipSchemaResourceSpec(),
+			Handler:  cypher.RelationshipSchemaResourceHandler(deps, sampleSize, s.schemaCache),
+		},
+	)
+}
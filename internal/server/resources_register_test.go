@@ -0,0 +1,73 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/server"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResourceRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aService := analytics.NewMockService(ctrl)
+	aService.EXPECT().IsEnabled().AnyTimes().Return(false)
+	aService.EXPECT().EmitEvent(gomock.Any()).AnyTimes()
+	aService.EXPECT().NewStartupEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockDB := getMockedDBService(ctrl, false)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+		return strings.Contains(query.(string), "apoc.meta.schema")
+	}), gomock.Any()).Return([]*neo4j.Record{
+		{Keys: []string{"key", "value"}, Values: []any{"Person", map[string]any{"type": "node", "properties": map[string]any{}}}},
+	}, nil)
+	cfg := &config.Config{
+		URI:              "bolt://test-host:7687",
+		Username:         "neo4j",
+		Password:         "password",
+		Database:         "neo4j",
+		SchemaSampleSize: 100,
+		TransportMode:    config.TransportModeStdio,
+	}
+	s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, aService)
+	if err := s.Start(); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	t.Run("registers the schema resource", func(t *testing.T) {
+		resources := s.MCPServer.ListResources()
+		if _, found := resources[cypher.SchemaResourceURI]; !found || len(resources) != 1 {
+			t.Errorf("Expected the %s resource, got %v", cypher.SchemaResourceURI, resources)
+		}
+	})
+
+	t.Run("reads a label through its resource template", func(t *testing.T) {
+		response := s.MCPServer.HandleMessage(context.Background(), json.RawMessage(
+			`{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"neo4j://schema/labels/Person"}}`,
+		))
+		rpcResponse, ok := response.(mcp.JSONRPCResponse)
+		if !ok {
+			t.Fatalf("Expected a JSON-RPC response, got %#v", response)
+		}
+		result, ok := rpcResponse.Result.(mcp.ReadResourceResult)
+		if !ok || len(result.Contents) != 1 {
+			t.Fatalf("Expected one resource content, got %#v", rpcResponse.Result)
+		}
+		text := result.Contents[0].(mcp.TextResourceContents).Text
+		if !strings.Contains(text, `"key":"Person"`) {
+			t.Errorf("Expected the Person label, got %s", text)
+		}
+	})
+}
@@ -0,0 +1,246 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+)
+
+const (
+	// schemaFingerprintTimeout bounds the queries of a schema fingerprint, which outlive the subscribe request.
+	schemaFingerprintTimeout = 30 * time.Second
+	// maxSchemaAuthFailures is the number of consecutive authentication failures after which the subscribers
+	// with the same credentials are dropped, so the checks do not lock the user out of Neo4j.
+	maxSchemaAuthFailures = 3
+)
+
+// schemaWatchKey identifies the schema seen by a subscriber, it depends on its privileges and its database.
+type schemaWatchKey struct {
+	database  string
+	principal string
+}
+
+func newSchemaWatchKey(ctx context.Context) schemaWatchKey {
+	targetDatabase, _ := database.GetTargetDatabase(ctx)
+	return schemaWatchKey{database: targetDatabase, principal: auth.Principal(ctx)}
+}
+
+// schemaSubscriber is a session subscribed to schema resources.
+type schemaSubscriber struct {
+	// ctx is the context of the last subscribe request of the session, without its cancellation:
+	// the schema is fingerprinted with its credentials and its database.
+	ctx  context.Context
+	key  schemaWatchKey
+	uris map[string]struct{}
+}
+
+// schemaSubscriptions tracks the sessions subscribed to the schema resources with resources/subscribe
+// and the last schema fingerprint seen by each, see checkSchemaSubscriptions.
+type schemaSubscriptions struct {
+	mu           sync.Mutex
+	subscribers  map[string]*schemaSubscriber // session id -> subscriber
+	fingerprints map[schemaWatchKey]string
+	authFailures map[schemaWatchKey]int // consecutive authentication failures of the fingerprints
+}
+
+func newSchemaSubscriptions() *schemaSubscriptions {
+	return &schemaSubscriptions{
+		subscribers:  make(map[string]*schemaSubscriber),
+		fingerprints: make(map[schemaWatchKey]string),
+		authFailures: make(map[schemaWatchKey]int),
+	}
+}
+
+// add subscribes the session to the URI, it reports whether the schema seen by the subscriber has no fingerprint yet.
+func (s *schemaSubscriptions) add(ctx context.Context, sessionID, uri string) (schemaWatchKey, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subscriber, ok := s.subscribers[sessionID]
+	if !ok {
+		subscriber = &schemaSubscriber{uris: make(map[string]struct{})}
+		s.subscribers[sessionID] = subscriber
+	}
+	subscriber.ctx = ctx
+	subscriber.key = newSchemaWatchKey(ctx)
+	subscriber.uris[uri] = struct{}{}
+	_, known := s.fingerprints[subscriber.key]
+	return subscriber.key, !known
+}
+
+// remove unsubscribes the session from the URI.
+func (s *schemaSubscriptions) remove(sessionID, uri string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if subscriber, ok := s.subscribers[sessionID]; ok {
+		delete(subscriber.uris, uri)
+		if len(subscriber.uris) == 0 {
+			delete(s.subscribers, sessionID)
+		}
+	}
+}
+
+// unregister is a server.OnUnregisterSessionHookFunc dropping the subscriptions of the session.
+func (s *schemaSubscriptions) unregister(_ context.Context, session server.ClientSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, session.SessionID())
+}
+
+// schemaWatchGroup is the subscribers seeing the same schema, it is fingerprinted once for all of them.
+type schemaWatchGroup struct {
+	ctx  context.Context
+	uris map[string][]string // session id -> subscribed URIs
+}
+
+// groups returns the subscribers grouped by the schema they see.
+// The fingerprints of the schemas without subscribers are dropped.
+func (s *schemaSubscriptions) groups() map[schemaWatchKey]*schemaWatchGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	groups := make(map[schemaWatchKey]*schemaWatchGroup)
+	for sessionID, subscriber := range s.subscribers {
+		group, ok := groups[subscriber.key]
+		if !ok {
+			group = &schemaWatchGroup{ctx: subscriber.ctx, uris: make(map[string][]string)}
+			groups[subscriber.key] = group
+		}
+		for uri := range subscriber.uris {
+			group.uris[sessionID] = append(group.uris[sessionID], uri)
+		}
+	}
+	for key := range s.fingerprints {
+		if _, ok := groups[key]; !ok {
+			delete(s.fingerprints, key)
+		}
+	}
+	for key := range s.authFailures {
+		if _, ok := groups[key]; !ok {
+			delete(s.authFailures, key)
+		}
+	}
+	return groups
+}
+
+// update records the fingerprint of the schema, it reports whether it differs from a previous one.
+func (s *schemaSubscriptions) update(key schemaWatchKey, fingerprint string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous, known := s.fingerprints[key]
+	s.fingerprints[key] = fingerprint
+	delete(s.authFailures, key)
+	return known && previous != fingerprint
+}
+
+// fail records a failed fingerprint of the schema. After maxSchemaAuthFailures consecutive authentication failures,
+// the subscribers seeing it are dropped, so their credentials are not tried again.
+func (s *schemaSubscriptions) fail(key schemaWatchKey, err error) {
+	if !database.IsAuthenticationError(err) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.authFailures[key]++
+	if s.authFailures[key] < maxSchemaAuthFailures {
+		return
+	}
+	dropped := 0
+	for sessionID, subscriber := range s.subscribers {
+		if subscriber.key == key {
+			delete(s.subscribers, sessionID)
+			dropped++
+		}
+	}
+	delete(s.authFailures, key)
+	delete(s.fingerprints, key)
+	slog.Warn("Dropped the resource subscriptions whose credentials are repeatedly refused", "sessions", dropped)
+}
+
+// subscribeToSchema is a server.OnAfterSubscribeFunc tracking the subscriptions to the schema resources.
+// The schema is fingerprinted when the subscriber is the first to see it, so a change before the next check is notified.
+func (s *Neo4jMCPServer) subscribeToSchema(ctx context.Context, _ any, request *mcp.SubscribeRequest, _ *mcp.EmptyResult) {
+	uri := request.Params.URI
+	clientSession := server.ClientSessionFromContext(ctx)
+	if clientSession == nil || !cypher.IsSchemaResourceURI(uri) {
+		slog.Debug("Ignoring a subscription to a resource without updates", "uri", uri)
+		return
+	}
+	key, unknown := s.subscriptions.add(context.WithoutCancel(ctx), clientSession.SessionID(), uri)
+	slog.Info("Client subscribed to a schema resource", "uri", uri)
+	if !unknown {
+		return
+	}
+	fingerprintCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), schemaFingerprintTimeout)
+	defer cancel()
+	fingerprint, err := cypher.SchemaFingerprint(fingerprintCtx, s.dbService)
+	if err != nil {
+		slog.Warn("Failed to fingerprint the schema, it is fingerprinted again on the next check", "error", err)
+		s.subscriptions.fail(key, err)
+		return
+	}
+	s.subscriptions.update(key, fingerprint)
+}
+
+// unsubscribeFromSchema is a server.OnAfterUnsubscribeFunc.
+func (s *Neo4jMCPServer) unsubscribeFromSchema(ctx context.Context, _ any, request *mcp.UnsubscribeRequest, _ *mcp.EmptyResult) {
+	if clientSession := server.ClientSessionFromContext(ctx); clientSession != nil {
+		s.subscriptions.remove(clientSession.SessionID(), request.Params.URI)
+	}
+}
+
+// checkSchemaSubscriptions fingerprints the schema seen by the subscribers and sends notifications/resources/updated
+// for the subscribed URIs when it changed. The schema cache is invalidated first, so the subscribers read the new schema.
+func (s *Neo4jMCPServer) checkSchemaSubscriptions(ctx context.Context) {
+	for key, group := range s.subscriptions.groups() {
+		if ctx.Err() != nil {
+			return
+		}
+		fingerprintCtx, cancel := context.WithTimeout(group.ctx, schemaFingerprintTimeout)
+		fingerprint, err := cypher.SchemaFingerprint(fingerprintCtx, s.dbService)
+		cancel()
+		if err != nil {
+			slog.Warn("Failed to fingerprint the schema of the resource subscriptions", "error", err)
+			s.subscriptions.fail(key, err)
+			continue
+		}
+		if !s.subscriptions.update(key, fingerprint) {
+			continue
+		}
+
+		slog.Info("The schema changed, notifying the resource subscribers", "sessions", len(group.uris))
+		if s.schemaCache != nil {
+			s.schemaCache.Invalidate()
+		}
+		for sessionID, uris := range group.uris {
+			for _, uri := range uris {
+				err := s.MCPServer.SendNotificationToSpecificClient(sessionID, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": uri})
+				if err != nil {
+					slog.Debug("Failed to notify a resource subscriber", "uri", uri, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// watchSchema checks the schema of the resource subscriptions every SchemaWatchInterval, until the context is done.
+func (s *Neo4jMCPServer) watchSchema(ctx context.Context) {
+	ticker := time.NewTicker(s.config.SchemaWatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkSchemaSubscriptions(ctx)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	analytics_mocks "github.com/neo4j/mcp/internal/analytics/mocks"
+	"github.com/neo4j/mcp/internal/config"
+	db_mocks "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func queryContains(part string) gomock.Matcher {
+	return gomock.Cond(func(query any) bool {
+		q, ok := query.(string)
+		return ok && strings.Contains(q, part)
+	})
+}
+
+// expectFingerprint mocks the queries of one schema fingerprint with the given labels.
+func expectFingerprint(mockDB *db_mocks.MockService, labels ...string) {
+	tokens := make([]*neo4j.Record, 0, len(labels))
+	for _, label := range labels {
+		tokens = append(tokens, &neo4j.Record{Keys: []string{"kind", "name"}, Values: []any{"label", label}})
+	}
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), queryContains("db.labels()"), gomock.Nil()).Times(1).Return(tokens, nil)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), queryContains("SHOW INDEXES"), gomock.Nil()).Times(1).Return([]*neo4j.Record{}, nil)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), queryContains("SHOW CONSTRAINTS"), gomock.Nil()).Times(1).Return([]*neo4j.Record{}, nil)
+}
+
+// handleResourceRequest sends a resources/subscribe or resources/unsubscribe request from the session.
+func handleResourceRequest(t *testing.T, s *Neo4jMCPServer, session *loggingSession, method, uri string) {
+	t.Helper()
+	ctx := s.MCPServer.WithContext(context.Background(), session)
+	message := `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":{"uri":"` + uri + `"}}`
+	if response, ok := s.MCPServer.HandleMessage(ctx, []byte(message)).(mcp.JSONRPCResponse); !ok {
+		t.Fatalf("%s failed: %+v", method, response)
+	}
+}
+
+func resourceUpdates(session *loggingSession) []string {
+	var uris []string
+	for {
+		select {
+		case notification := <-session.notifications:
+			if notification.Method == mcp.MethodNotificationResourceUpdated {
+				uris = append(uris, notification.Params.AdditionalFields["uri"].(string))
+			}
+		default:
+			return uris
+		}
+	}
+}
+
+func TestSchemaSubscriptions(t *testing.T) {
+	newTestServer := func(t *testing.T, mockDB *db_mocks.MockService) (*Neo4jMCPServer, *loggingSession) {
+		cfg := &config.Config{Database: "neo4j", TransportMode: config.TransportModeStdio, SchemaWatchInterval: time.Minute}
+		s := NewNeo4jMCPServer("test-version", cfg, mockDB, analytics_mocks.NewMockService(gomock.NewController(t)))
+		s.registerResources()
+		session := &loggingSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+		if err := s.MCPServer.RegisterSession(context.Background(), session); err != nil {
+			t.Fatalf("failed to register the session: %v", err)
+		}
+		return s, session
+	}
+
+	t.Run("notifies the subscribers when the schema changes", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectFingerprint(mockDB, "Person")
+		expectFingerprint(mockDB, "Person")
+		expectFingerprint(mockDB, "Person", "Movie")
+		s, session := newTestServer(t, mockDB)
+
+		handleResourceRequest(t, s, session, string(mcp.MethodResourcesSubscribe), "neo4j://schema")
+
+		s.checkSchemaSubscriptions(context.Background())
+		if uris := resourceUpdates(session); len(uris) != 0 {
+			t.Fatalf("expected no notification while the schema is unchanged, got %v", uris)
+		}
+
+		s.checkSchemaSubscriptions(context.Background())
+		if uris := resourceUpdates(session); len(uris) != 1 || uris[0] != "neo4j://schema" {
+			t.Fatalf("expected a notification for neo4j://schema, got %v", uris)
+		}
+	})
+
+	t.Run("stops checking the schema once unsubscribed", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		expectFingerprint(mockDB, "Person")
+		s, session := newTestServer(t, mockDB)
+
+		handleResourceRequest(t, s, session, string(mcp.MethodResourcesSubscribe), "neo4j://schema/labels/Person")
+		handleResourceRequest(t, s, session, string(mcp.MethodResourcesUnsubscribe), "neo4j://schema/labels/Person")
+
+		// No query is expected
+		s.checkSchemaSubscriptions(context.Background())
+		if len(s.subscriptions.fingerprints) != 0 {
+			t.Errorf("expected the fingerprints without subscribers to be dropped, got %d", len(s.subscriptions.fingerprints))
+		}
+	})
+
+	t.Run("drops the subscribers after repeated authentication failures", func(t *testing.T) {
+		mockDB := db_mocks.NewMockService(gomock.NewController(t))
+		unauthorized := &neo4j.Neo4jError{Code: "Neo.ClientError.Security.Unauthorized"}
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), queryContains("db.labels()"), gomock.Nil()).
+			Times(maxSchemaAuthFailures).
+			Return(nil, fmt.Errorf("failed to execute read query: %w", unauthorized))
+		s, session := newTestServer(t, mockDB)
+
+		handleResourceRequest(t, s, session, string(mcp.MethodResourcesSubscribe), "neo4j://schema")
+		for range maxSchemaAuthFailures - 1 {
+			s.checkSchemaSubscriptions(context.Background())
+		}
+
+		if len(s.subscriptions.subscribers) != 0 {
+			t.Errorf("expected the subscriber to be dropped, got %d", len(s.subscriptions.subscribers))
+		}
+		// No query is expected
+		s.checkSchemaSubscriptions(context.Background())
+	})
+
+	t.Run("ignores the subscriptions to other resources", func(t *testing.T) {
+		s, session := newTestServer(t, db_mocks.NewMockService(gomock.NewController(t)))
+
+		handleResourceRequest(t, s, session, string(mcp.MethodResourcesSubscribe), "file:///tmp/other")
+
+		if len(s.subscriptions.subscribers) != 0 {
+			t.Errorf("expected no subscriber, got %d", len(s.subscriptions.subscribers))
+		}
+	})
+
+	t.Run("subscriptions are disabled in HTTP mode", func(t *testing.T) {
+		cfg := &config.Config{Database: "neo4j", TransportMode: config.TransportModeHTTP, SchemaWatchInterval: time.Minute}
+		s := NewNeo4jMCPServer("test-version", cfg, db_mocks.NewMockService(gomock.NewController(t)), analytics_mocks.NewMockService(gomock.NewController(t)))
+		if s.subscriptions != nil {
+			t.Error("expected no resource subscriptions in HTTP mode")
+		}
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"log"
 	"log/slog"
 	"net/http"
 	"os"
@@ -21,8 +20,12 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/completions"
 	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/logger"
+	"github.com/neo4j/mcp/internal/session"
+	"github.com/neo4j/mcp/internal/tools/cypher"
 	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
 )
 
@@ -46,9 +49,18 @@ type Neo4jMCPServer struct {
 	dbService          database.Service
 	version            string
 	anService          analytics.Service
-	gdsInstalled       bool
+	schemaCache        *cypher.SchemaCache  // nil when the schema cache is disabled
+	logNotifier        *logNotifier         // nil in HTTP mode, where the logs of all the users are not forwarded
+	sessions           *session.Store       // nil unless the stateful HTTP mode is enabled
+	sseSessions        *sseSessionOwners    // nil unless in SSE mode
+	subscriptions      *schemaSubscriptions // nil when disabled, and in HTTP mode where the sessions do not last
 	initMu             sync.Mutex
 	connectionVerified atomic.Bool
+	// capabilities are guarded by capabilitiesMu once the server is started, see refreshCapabilities
+	capabilities         capabilities
+	capabilitiesProbedAt time.Time      // zero until the first probe
+	recentProbes         []capabilities // the last capabilityRemovalProbes successful probes
+	capabilitiesMu       sync.Mutex
 }
 
 // NewNeo4jMCPServer creates a new MCP server instance
@@ -62,21 +74,54 @@ func NewNeo4jMCPServer(version string, cfg *config.Config, dbService database.Se
 		dbService:       dbService,
 		version:         version,
 		anService:       anService,
-		gdsInstalled:    false,
+		capabilities:    defaultCapabilities(),
+	}
+	if cfg != nil {
+		neo4jServer.schemaCache = cypher.NewSchemaCache(cfg.SchemaCacheTTL)
+		if cfg.TransportMode == config.TransportModeStdio {
+			neo4jServer.logNotifier = newLogNotifier()
+		}
+		if cfg.TransportMode == config.TransportModeHTTP && cfg.HTTPStateful {
+			neo4jServer.sessions = session.NewStore(int(cfg.HTTPMaxSessions), cfg.HTTPSessionIdleTimeout)
+		}
+		if cfg.TransportMode == config.TransportModeSSE {
+			neo4jServer.sseSessions = newSSESessionOwners()
+		}
+		if (cfg.TransportMode == config.TransportModeStdio || cfg.TransportMode == config.TransportModeSSE) && cfg.SchemaWatchInterval > 0 {
+			neo4jServer.subscriptions = newSchemaSubscriptions()
+		}
 	}
 
 	hooks := neo4jServer.configureHooks()
+	// Completions only use the database service and the allowed databases, known at this point
+	completionProvider := completions.NewProvider(neo4jServer.newToolDependencies())
 
-	mcpServer := server.NewMCPServer(
-		"neo4j-mcp",
-		version,
+	serverOptions := []server.ServerOption{
 		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(neo4jServer.subscriptions != nil, false),
+		server.WithPromptCapabilities(false),
+		server.WithCompletions(),
+		server.WithPromptCompletionProvider(completionProvider),
+		server.WithResourceCompletionProvider(completionProvider),
 		server.WithHooks(hooks),
-		server.WithInstructions("This is the Neo4j official MCP server and can provide tool calling to interact with your Neo4j database,"+
-			"by inferring the schema with tools like get-schema and executing arbitrary Cypher queries with read-cypher."),
-	)
+		server.WithInstructions("This is the Neo4j official MCP server and can provide tool calling to interact with your Neo4j database," +
+			"by inferring the schema with tools like get-schema and executing arbitrary Cypher queries with read-cypher. " +
+			"The schema is also available as the neo4j://schema resource, and prompts such as write-cypher-query " +
+			"and explore-graph guide Cypher authoring and graph exploration with the live schema."),
+	}
+	if neo4jServer.logNotifier != nil {
+		serverOptions = append(serverOptions, server.WithLogging())
+	}
+	if neo4jServer.sessions != nil {
+		serverOptions = append(serverOptions, server.WithToolHandlerMiddleware(neo4jServer.sessionToolMiddleware))
+	}
+
+	mcpServer := server.NewMCPServer("neo4j-mcp", version, serverOptions...)
 
 	neo4jServer.MCPServer = mcpServer
+	if neo4jServer.logNotifier != nil {
+		neo4jServer.logNotifier.srv = mcpServer
+	}
 
 	return neo4jServer
 }
@@ -85,16 +130,21 @@ func NewNeo4jMCPServer(version string, cfg *config.Config, dbService database.Se
 func (s *Neo4jMCPServer) Start() error {
 
 	switch s.config.TransportMode {
-	case config.TransportModeHTTP:
-		slog.Info("Registering server tools")
+	case config.TransportModeHTTP, config.TransportModeSSE:
+		slog.Info("Registering server tools, resources and prompts")
 		if err := s.registerTools(); err != nil {
 			return err
 		}
+		s.registerResources()
+		s.registerPrompts()
 		// in case of http mode, the initialization process is delayed until the credentials are available.
 		// when the first client is performing the initialize request then the server perform
 
 		s.emitServerStartupEvent()
 
+		if s.config.TransportMode == config.TransportModeSSE {
+			return s.StartSSEServer()
+		}
 		return s.StartHTTPServer()
 	case config.TransportModeStdio:
 		{
@@ -107,14 +157,28 @@ func (s *Neo4jMCPServer) Start() error {
 			if err := s.registerTools(); err != nil {
 				return fmt.Errorf("failed to register tools: %w", err)
 			}
+			s.registerResources()
+			s.registerPrompts()
 
 			s.emitServerStartupEvent()
-			s.emitConnectionInitializedEvent(context.Background())
+			s.emitConnectionInitializedEvent()
 			slog.Info(
 				fmt.Sprintf("Starting Neo4j MCP server version %s in STDIO mode", s.version),
 				"version", s.version,
 			)
 
+			// Forward the logs to the client, at the level it selects with logging/setLevel
+			logger.SetNotifier(s.logNotifier)
+			defer logger.SetNotifier(nil)
+
+			// Probe the capabilities again while serving, the tools follow plugin installs and upgrades
+			watchCtx, stopWatching := context.WithCancel(context.Background())
+			defer stopWatching()
+			go s.watchCapabilities(watchCtx)
+			if s.subscriptions != nil {
+				go s.watchSchema(watchCtx)
+			}
+
 			return server.ServeStdio(s.MCPServer)
 		}
 	default:
@@ -158,60 +222,17 @@ func parseAllowedOrigins(allowedOriginsStr string) []string {
 	return allowedOrigins
 }
 
-// verifyRequirements check the Neo4j requirements:
-// - A valid connection with a Neo4j instance.
-// - The ability to perform a read query (database name is correctly defined).
-// - Required plugin installed: APOC (specifically apoc.meta.schema as it's used for get-schema)
-// - In case GDS is not installed a flag is set in the server and tools will be registered accordingly
+// verifyRequirements checks the Neo4j requirements and probes the capabilities before the tools are registered,
+// see probeCapabilities. It is used in STDIO mode, HTTP mode probes them on the first initialize request.
 func (s *Neo4jMCPServer) verifyRequirements(ctx context.Context) error {
-	// Use a timeout to fail fast if the Neo4j instance is unreachable (e.g., TCP connection refused,
-	// DNS failure, network failure). Without this, ExecuteReadQuery can block for minutes waiting for
-	// the driver's internal connection pool timeout.
-	verifyCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	// Perform a dummy query to verify correctness of the connection.
-	records, err := s.dbService.ExecuteReadQuery(verifyCtx, "RETURN 1 as first", map[string]any{})
-
-	if err != nil {
-		return fmt.Errorf("impossible to verify connectivity with the Neo4j instance: %w", err)
-	}
-	if len(records) != 1 || len(records[0].Values) != 1 {
-		return fmt.Errorf("failed to verify connectivity with the Neo4j instance: unexpected response from test query")
-	}
-	one, ok := records[0].Values[0].(int64)
-	if !ok || one != 1 {
-		return fmt.Errorf("failed to verify connectivity with the Neo4j instance: unexpected response from test query")
-	}
-	// Check for apoc.meta.schema procedure
-	checkApocMetaSchemaQuery := "SHOW PROCEDURES YIELD name WHERE name = 'apoc.meta.schema' RETURN count(name) > 0 AS apocMetaSchemaAvailable"
-
-	// Check for apoc.meta.schema availability
-	records, err = s.dbService.ExecuteReadQuery(ctx, checkApocMetaSchemaQuery, nil)
-	if err != nil {
-		return fmt.Errorf("failed to check for APOC availability: %w", err)
-	}
-	if len(records) != 1 || len(records[0].Values) != 1 {
-		return fmt.Errorf("failed to verify APOC availability: unexpected response from test query")
-	}
-	apocMetaSchemaAvailable, ok := records[0].Values[0].(bool)
-	if !ok || !apocMetaSchemaAvailable {
-		return fmt.Errorf("please ensure the APOC plugin is installed and includes the 'meta' component")
-	}
-	// Call gds.version procedure to determine if GDS is installed
-	records, err = s.dbService.ExecuteReadQuery(ctx, "RETURN gds.version() as gdsVersion", nil)
+	caps, err := s.probeCapabilities(ctx)
 	if err != nil {
-		// GDS is optional, so we log a warning and continue, assuming it's not installed.
-		log.Print("Impossible to verify GDS installation.")
-		s.gdsInstalled = false
-		return nil
-	}
-	if len(records) == 1 && len(records[0].Values) == 1 {
-		_, ok := records[0].Values[0].(string)
-		if ok {
-			s.gdsInstalled = true
-		}
+		return err
 	}
-
+	s.capabilities = caps
+	s.capabilitiesProbedAt = time.Now()
+	s.recentProbes = []capabilities{caps}
+	caps.log("Neo4j capabilities probed")
 	return nil
 }
 
@@ -220,20 +241,17 @@ func (s *Neo4jMCPServer) emitServerStartupEvent() {
 	s.anService.EmitEvent(s.anService.NewStartupEvent(s.config.TransportMode, s.config.HTTPTLSEnabled, s.version))
 }
 
-// emitConnectionInitializedEvent emits the connection initialized event with DB information (STDIO mode only)
-func (s *Neo4jMCPServer) emitConnectionInitializedEvent(ctx context.Context) {
+// emitConnectionInitializedEvent emits the connection initialized event with the DB information collected by the capabilities probe
+func (s *Neo4jMCPServer) emitConnectionInitializedEvent() {
 	if !s.anService.IsEnabled() {
 		return
 	}
 
-	records, err := s.dbService.ExecuteReadQuery(ctx, "CALL dbms.components()", map[string]any{})
-	if err != nil {
-		slog.Debug("Failed to collect connection metadata", "error", err.Error())
+	connInfo := s.capabilities.connInfo
+	if connInfo == nil {
 		return
 	}
-
-	connInfo := recordsToConnectionEventInfo(records)
-	s.anService.EmitEvent(s.anService.NewConnectionInitializedEvent(connInfo))
+	s.anService.EmitEvent(s.anService.NewConnectionInitializedEvent(*connInfo))
 }
 
 // recordsToConnectionEventInfo converts dbms.components() records to ConnectionEventInfo
@@ -331,7 +349,7 @@ func (s *Neo4jMCPServer) Stop(ctx context.Context) error {
 			slog.Error("Error shutting down HTTP server", "error", err)
 			return err
 		}
-		// Signal the StartHTTPServer goroutine to exit
+		// Signal serveHTTP to exit
 		close(s.shutdownChan)
 		slog.Info("HTTP server stopped")
 	}
@@ -339,6 +357,50 @@ func (s *Neo4jMCPServer) Stop(ctx context.Context) error {
 }
 
 func (s *Neo4jMCPServer) StartHTTPServer() error {
+	// Create the StreamableHTTPServer - it serves on /mcp path by default.
+	// It is stateless unless enabled, then it issues Mcp-Session-Id and keeps the sessions in the store.
+	httpOptions := []server.StreamableHTTPOption{server.WithStateLess(true)}
+	if s.sessions != nil {
+		httpOptions = []server.StreamableHTTPOption{server.WithSessionIdManagerResolver(sessionIDManagerResolver{store: s.sessions})}
+		if s.config.HTTPSessionIdleTimeout > 0 {
+			httpOptions = append(httpOptions, server.WithSessionIdleTTL(s.config.HTTPSessionIdleTimeout))
+		}
+		slog.Info("Stateful HTTP sessions enabled", "maxSessions", s.config.HTTPMaxSessions, "idleTimeout", s.config.HTTPSessionIdleTimeout)
+	}
+	var mcpServerHTTP http.Handler = server.NewStreamableHTTPServer(s.MCPServer, httpOptions...)
+	if s.sessions != nil {
+		mcpServerHTTP = sessionCapacityMiddleware(s.sessions)(mcpServerHTTP)
+	}
+
+	return s.serveHTTP("HTTP", mcpServerHTTP, nil)
+}
+
+// StartSSEServer serves the legacy HTTP+SSE transport: the client opens an event stream with GET /sse
+// and posts its messages to the /message endpoint announced on the stream. The auth, CORS and TLS
+// settings are the ones of the streamable HTTP mode.
+func (s *Neo4jMCPServer) StartSSEServer() error {
+	mcpServerSSE := server.NewSSEServer(
+		s.MCPServer,
+		server.WithSSEEndpoint(sseEndpoint),
+		server.WithMessageEndpoint(sseMessageEndpoint),
+		server.WithKeepAlive(true),
+		server.WithKeepAliveInterval(sseKeepAliveInterval),
+	)
+
+	// Notify the resource subscribers of the schema changes while serving
+	if s.subscriptions != nil {
+		watchCtx, stopWatching := context.WithCancel(context.Background())
+		defer stopWatching()
+		go s.watchSchema(watchCtx)
+	}
+
+	// The streams are open until the client disconnects, close them so the shutdown does not wait for them
+	return s.serveHTTP("SSE", sseStreamMiddleware(mcpServerSSE), mcpServerSSE.CloseSessions)
+}
+
+// serveHTTP serves the MCP handler behind the middleware chain until the server is stopped or receives
+// a shutdown signal. onShutdown, when set, is called when the shutdown starts.
+func (s *Neo4jMCPServer) serveHTTP(mode string, mcpHandler http.Handler, onShutdown func()) error {
 	addr := fmt.Sprintf("%s:%s", s.config.HTTPHost, s.config.HTTPPort)
 	protocol := protocolHTTP
 	if s.config.HTTPTLSEnabled {
@@ -346,32 +408,26 @@ func (s *Neo4jMCPServer) StartHTTPServer() error {
 	}
 
 	slog.Info(
-		fmt.Sprintf("Starting Neo4j MCP server version %s in HTTP mode", s.version),
+		fmt.Sprintf("Starting Neo4j MCP server version %s in %s mode", s.version, mode),
 		"version", s.version,
 		"address", addr,
 		"url", fmt.Sprintf("%s://%s", protocol, addr),
 		"tls", s.config.HTTPTLSEnabled,
 	)
 
-	// Create the StreamableHTTPServer - it serves on /mcp path by default
-	mcpServerHTTP := server.NewStreamableHTTPServer(
-		s.MCPServer,
-		server.WithStateLess(true),
-	)
-
 	allowedOrigins := parseAllowedOrigins(s.config.HTTPAllowedOrigins)
 
 	// Route /healthz directly (no auth required).
 	// All other paths go through the full middleware chain which enforces auth and path validation.
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", healthzHandler)
-	mux.Handle("/", s.chainMiddleware(allowedOrigins, mcpServerHTTP))
+	mux.Handle("/", s.chainMiddleware(allowedOrigins, mcpHandler))
 
 	// Wrap handler with middleware and create HTTP server
 	s.httpServer = &http.Server{
 		Addr:    addr,
 		Handler: mux,
-		// Timeouts optimized for stateless HTTP MCP requests
+		// Timeouts optimized for short HTTP MCP requests, in stateless and stateful mode
 		ReadTimeout:       serverHTTPReadTimeout,
 		WriteTimeout:      serverHTTPWriteTimeout,
 		IdleTimeout:       serverHTTPIdleTimeout,
@@ -387,6 +443,9 @@ func (s *Neo4jMCPServer) StartHTTPServer() error {
 		s.httpServer.TLSConfig = tlsConfig
 		slog.Info("TLS configuration applied", "minVersion", "TLS 1.2 (allows TLS 1.3 negotiation)")
 	}
+	if onShutdown != nil {
+		s.httpServer.RegisterOnShutdown(onShutdown)
+	}
 
 	// Signal that httpServer is ready for reading
 	close(s.HTTPServerReady)
@@ -438,10 +497,23 @@ func (s *Neo4jMCPServer) configureHooks() *server.Hooks {
 	hooks := &server.Hooks{}
 
 	hooks.AddAfterCallTool(s.handleToolCallComplete)
-	if s.config.TransportMode == config.TransportModeHTTP {
+	if s.logNotifier != nil {
+		hooks.AddOnRegisterSession(s.logNotifier.register)
+		hooks.AddOnUnregisterSession(s.logNotifier.unregister)
+	}
+	if s.sseSessions != nil {
+		hooks.AddOnRegisterSession(s.sseSessions.register)
+		hooks.AddOnUnregisterSession(s.sseSessions.unregister)
+	}
+	if s.subscriptions != nil {
+		hooks.AddAfterSubscribe(s.subscribeToSchema)
+		hooks.AddAfterUnsubscribe(s.unsubscribeFromSchema)
+		hooks.AddOnUnregisterSession(s.subscriptions.unregister)
+	}
+	if s.config.TransportMode.IsHTTP() {
 		hooks.AddBeforeInitialize(func(ctx context.Context, _ any, _ *mcp.InitializeRequest) {
-			// if requirements and events are already verified/sent return
-			if s.connectionVerified.Load() {
+			// if requirements and events are already verified/sent, and the capabilities are recent, return
+			if s.connectionVerified.Load() && !s.capabilitiesStale() {
 				return
 			}
 			// lock
@@ -450,20 +522,25 @@ func (s *Neo4jMCPServer) configureHooks() *server.Hooks {
 
 			// cover edge case "connectionVerified" stored in between check and lock
 			if s.connectionVerified.Load() {
+				// The capabilities are probed again with the credentials of the initializing client,
+				// no background probe can run in HTTP mode where the credentials come with the requests
+				if !s.capabilitiesStale() {
+					return
+				}
+				if err := s.refreshCapabilities(ctx); err != nil {
+					slog.Warn("Failed to probe the Neo4j capabilities, the tools are unchanged", "error", err)
+				}
 				return
 			}
 
 			slog.Info("Verify server requirements...")
-			if err := s.verifyRequirements(ctx); err != nil {
+			// The tools registered at startup assumed the default capabilities, they are updated to the probed ones
+			if err := s.refreshCapabilities(ctx); err != nil {
 				slog.Error("Error during verification", "error", err)
 				return
 			}
 
-			if s.gdsInstalled {
-				s.addGDSTools()
-			}
-
-			s.emitConnectionInitializedEvent(ctx)
+			s.emitConnectionInitializedEvent()
 
 			s.connectionVerified.Store(true)
 		})
@@ -0,0 +1,144 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/session"
+)
+
+// sessionSettingsTool changes the session settings, it is allowed in a read-only session
+const sessionSettingsTool = "session-settings"
+
+var errSessionOwner = errors.New("session belongs to other credentials")
+
+// sessionIDManagerResolver issues and validates the Mcp-Session-Id of the stateful HTTP sessions kept in the store.
+// It implements server.SessionIdManagerResolver: the manager of a request is bound to its credentials,
+// so a session can only be used with the credentials that created it.
+type sessionIDManagerResolver struct {
+	store *session.Store
+}
+
+func (r sessionIDManagerResolver) ResolveSessionIdManager(req *http.Request) server.SessionIdManager {
+	manager := &sessionIDManager{store: r.store}
+	// The idle session sweeper resolves a manager without a request
+	if req != nil {
+		manager.principal = auth.Principal(req.Context())
+	}
+	return manager
+}
+
+// sessionIDManager implements server.SessionIdManager for the credentials of one request.
+type sessionIDManager struct {
+	store *session.Store
+	// principal of the request credentials, see auth.Principal. It is empty for the sweeper
+	// and for the methods allowed without credentials, which are not checked against the session owner.
+	principal string
+}
+
+func (m *sessionIDManager) Generate() string {
+	s, err := m.store.Create(m.principal)
+	if err != nil {
+		// sessionCapacityMiddleware refuses the requests when the store is full, it filled up in the meantime.
+		// The id is not in the store, so the following requests of the client are refused.
+		slog.Warn("Refused a new session", "error", err)
+		return uuid.NewString()
+	}
+	return s.ID()
+}
+
+func (m *sessionIDManager) Validate(sessionID string) (bool, error) {
+	s, err := m.store.Get(sessionID)
+	if err != nil {
+		return false, err
+	}
+	if m.principal != "" && s.Owner() != m.principal {
+		slog.Warn("Refused a request using a session created with other credentials")
+		return false, errSessionOwner
+	}
+	return false, nil
+}
+
+func (m *sessionIDManager) Terminate(sessionID string) (bool, error) {
+	if m.principal != "" {
+		s, err := m.store.Get(sessionID)
+		if err != nil {
+			// Already gone, terminating it again is not an error
+			return false, nil
+		}
+		if s.Owner() != m.principal {
+			return true, nil
+		}
+	}
+	m.store.Delete(sessionID)
+	return false, nil
+}
+
+// sessionCapacityMiddleware refuses with 503 the requests creating a session, the ones without
+// an Mcp-Session-Id, while the session store is full. The existing sessions are never evicted for them.
+func sessionCapacityMiddleware(store *session.Store) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			createsSession := r.Method == http.MethodPost || r.Method == http.MethodGet
+			if createsSession && r.Header.Get(server.HeaderKeySessionID) == "" && store.Full() {
+				slog.Warn("Refused a new session, the session store is full")
+				http.Error(w, "Service Unavailable: too many sessions", http.StatusServiceUnavailable)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// sessionToolMiddleware makes the session of the request available to the tool handlers (see session.FromContext)
+// and applies its settings: the session database is targeted unless the call or the X-Neo4j-Database header
+// selects one, the queries use the session bookmarks, and the tools modifying the database are refused
+// in a read-only session.
+func (s *Neo4jMCPServer) sessionToolMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		clientSession := server.ClientSessionFromContext(ctx)
+		if clientSession == nil {
+			return next(ctx, request)
+		}
+		sess, err := s.sessions.Get(clientSession.SessionID())
+		if err != nil {
+			// The transport validated the session, it expired or was deleted in the meantime
+			slog.Error("error retrieving the session", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("%v, initialize a new session", err)), nil
+		}
+
+		settings := sess.Settings()
+		if settings.ReadOnly && request.Params.Name != sessionSettingsTool && !s.isReadOnlyTool(request.Params.Name) {
+			errMessage := fmt.Sprintf("%s is not allowed in a read-only session, initialize a new session to use it", request.Params.Name)
+			slog.Error("rejected a tool call in a read-only session", "tool", request.Params.Name)
+			return mcp.NewToolResultError(errMessage), nil
+		}
+
+		ctx = session.WithSession(ctx, sess)
+		if _, ok := database.GetTargetDatabase(ctx); !ok && settings.Database != "" {
+			ctx = database.WithTargetDatabase(ctx, settings.Database)
+		}
+		ctx = database.WithBookmarkManager(ctx, sess.BookmarkManager())
+		return next(ctx, request)
+	}
+}
+
+// isReadOnlyTool reports whether the registered tool is annotated as read-only.
+func (s *Neo4jMCPServer) isReadOnlyTool(name string) bool {
+	tool := s.MCPServer.GetTool(name)
+	if tool == nil || tool.Tool.Annotations.ReadOnlyHint == nil {
+		return false
+	}
+	return *tool.Tool.Annotations.ReadOnlyHint
+}
@@ -0,0 +1,184 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/session"
+)
+
+// statefulSession is a server.ClientSession with the id of a session of the store.
+type statefulSession struct {
+	id string
+}
+
+func (s *statefulSession) Initialize()                                         {}
+func (s *statefulSession) Initialized() bool                                   { return true }
+func (s *statefulSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s *statefulSession) SessionID() string                                   { return s.id }
+
+func TestSessionIDManager(t *testing.T) {
+	store := session.NewStore(10, 0)
+	resolver := sessionIDManagerResolver{store: store}
+	resolve := func(user string) server.SessionIdManager {
+		req := httptest.NewRequest("POST", "/mcp", nil)
+		if user != "" {
+			req = req.WithContext(auth.WithBasicAuth(req.Context(), user, "password"))
+		}
+		return resolver.ResolveSessionIdManager(req)
+	}
+
+	sessionID := resolve("alice").Generate()
+
+	if _, err := resolve("alice").Validate(sessionID); err != nil {
+		t.Errorf("Expected the owner to use the session, got %v", err)
+	}
+	if _, err := resolve("bob").Validate(sessionID); err == nil {
+		t.Error("Expected other credentials to be refused")
+	}
+	if _, err := resolve("").Validate(sessionID); err != nil {
+		t.Errorf("Expected the methods allowed without credentials to use the session, got %v", err)
+	}
+	if _, err := resolve("alice").Validate("unknown"); err == nil {
+		t.Error("Expected an unknown session to be refused")
+	}
+
+	if notAllowed, _ := resolve("bob").Terminate(sessionID); !notAllowed {
+		t.Error("Expected other credentials not to terminate the session")
+	}
+	if notAllowed, err := resolve("alice").Terminate(sessionID); notAllowed || err != nil {
+		t.Errorf("Expected the owner to terminate the session, got %v, %v", notAllowed, err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Expected the session to be deleted, got %d sessions", store.Len())
+	}
+
+	// The idle session sweeper terminates the sessions without a request
+	sessionID = resolve("alice").Generate()
+	if _, err := resolver.ResolveSessionIdManager(nil).Terminate(sessionID); err != nil || store.Len() != 0 {
+		t.Errorf("Expected the sweeper to delete the session, got %v and %d sessions", err, store.Len())
+	}
+}
+
+func TestSessionCapacityMiddleware(t *testing.T) {
+	store := session.NewStore(1, 0)
+	handler := sessionCapacityMiddleware(store)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(method, sessionID string) int {
+		req := httptest.NewRequest(method, "/mcp", nil)
+		if sessionID != "" {
+			req.Header.Set(server.HeaderKeySessionID, sessionID)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := serve(http.MethodPost, ""); code != http.StatusOK {
+		t.Errorf("Expected a new session to be allowed, got %d", code)
+	}
+	sess, err := store.Create("basic:alice")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if code := serve(http.MethodPost, ""); code != http.StatusServiceUnavailable {
+		t.Errorf("Expected a new session to be refused when the store is full, got %d", code)
+	}
+	if code := serve(http.MethodGet, ""); code != http.StatusServiceUnavailable {
+		t.Errorf("Expected a new stream session to be refused when the store is full, got %d", code)
+	}
+	if code := serve(http.MethodPost, sess.ID()); code != http.StatusOK {
+		t.Errorf("Expected the existing session to be served, got %d", code)
+	}
+
+	// A session created once the store filled up is not kept, so the client cannot use it
+	sessionID := sessionIDManagerResolver{store: store}.ResolveSessionIdManager(httptest.NewRequest("POST", "/mcp", nil)).Generate()
+	if _, err := store.Get(sessionID); err == nil {
+		t.Error("Expected no session to be created when the store is full")
+	}
+	if _, err := store.Get(sess.ID()); err != nil {
+		t.Errorf("Expected the existing session to be kept, got %v", err)
+	}
+}
+
+func TestSessionToolMiddleware(t *testing.T) {
+	s := mockNeo4jMCPServer(t)
+	s.sessions = session.NewStore(10, 0)
+	sess, err := s.sessions.Create("basic:neo4j")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess.UpdateSettings(func(settings *session.Settings) { settings.Database = "movies" })
+	s.MCPServer.AddTool(mcp.NewTool("reader", mcp.WithReadOnlyHintAnnotation(true)), nil)
+	s.MCPServer.AddTool(mcp.NewTool("writer", mcp.WithReadOnlyHintAnnotation(false)), nil)
+	s.MCPServer.AddTool(mcp.NewTool(sessionSettingsTool, mcp.WithReadOnlyHintAnnotation(false)), nil)
+
+	var handledCtx context.Context
+	handler := s.sessionToolMiddleware(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		handledCtx = ctx
+		return mcp.NewToolResultText("ok"), nil
+	})
+	call := func(ctx context.Context, tool string) *mcp.CallToolResult {
+		handledCtx = nil
+		request := mcp.CallToolRequest{}
+		request.Params.Name = tool
+		result, err := handler(s.MCPServer.WithContext(ctx, &statefulSession{id: sess.ID()}), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	t.Run("applies the session settings", func(t *testing.T) {
+		call(context.Background(), "writer")
+
+		if got, ok := session.FromContext(handledCtx); !ok || got != sess {
+			t.Error("Expected the session in the context")
+		}
+		if target, _ := database.GetTargetDatabase(handledCtx); target != "movies" {
+			t.Errorf("Expected the session database, got %q", target)
+		}
+	})
+
+	t.Run("keeps the database selected by the request", func(t *testing.T) {
+		call(database.WithTargetDatabase(context.Background(), "neo4j"), "reader")
+
+		if target, _ := database.GetTargetDatabase(handledCtx); target != "neo4j" {
+			t.Errorf("Expected the database of the request, got %q", target)
+		}
+	})
+
+	t.Run("refuses the write tools in a read-only session", func(t *testing.T) {
+		sess.UpdateSettings(func(settings *session.Settings) { settings.ReadOnly = true })
+		defer sess.UpdateSettings(func(settings *session.Settings) { settings.ReadOnly = false })
+
+		if result := call(context.Background(), "writer"); !result.IsError || handledCtx != nil {
+			t.Error("Expected writer to be refused")
+		}
+		if result := call(context.Background(), "reader"); result.IsError || handledCtx == nil {
+			t.Error("Expected reader to be called")
+		}
+		if result := call(context.Background(), sessionSettingsTool); result.IsError || handledCtx == nil {
+			t.Error("Expected session-settings to be called")
+		}
+	})
+
+	t.Run("refuses an expired session", func(t *testing.T) {
+		s.sessions.Delete(sess.ID())
+
+		if result := call(context.Background(), "reader"); !result.IsError || handledCtx != nil {
+			t.Error("Expected the call to be refused")
+		}
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/auth"
+)
+
+const (
+	sseEndpoint          = "/sse"
+	sseMessageEndpoint   = "/message"
+	sseKeepAliveInterval = 30 * time.Second // pings the client so proxies do not close an idle stream
+)
+
+// sseSessionOwners binds the SSE sessions to the credentials that opened their stream,
+// so the messages of a session can only be posted with the same credentials.
+type sseSessionOwners struct {
+	mu     sync.Mutex
+	owners map[string]string // session id -> principal, see auth.Principal
+}
+
+func newSSESessionOwners() *sseSessionOwners {
+	return &sseSessionOwners{owners: make(map[string]string)}
+}
+
+// register is an OnRegisterSession hook, the context is the one of the GET /sse request.
+func (o *sseSessionOwners) register(ctx context.Context, clientSession server.ClientSession) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.owners[clientSession.SessionID()] = auth.Principal(ctx)
+}
+
+// unregister is an OnUnregisterSession hook, called when the stream is closed.
+func (o *sseSessionOwners) unregister(_ context.Context, clientSession server.ClientSession) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.owners, clientSession.SessionID())
+}
+
+// isOwner reports whether the session exists and was opened with the credentials of principal.
+func (o *sseSessionOwners) isOwner(sessionID, principal string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	owner, ok := o.owners[sessionID]
+	return ok && owner == principal
+}
+
+// sessionOwnerMiddleware rejects the messages posted to a session opened with other credentials.
+// It returns 404 like an unknown session, not to reveal the sessions of the other users.
+func (o *sseSessionOwners) sessionOwnerMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == sseMessageEndpoint {
+			if !o.isOwner(r.URL.Query().Get("sessionId"), auth.Principal(r.Context())) {
+				slog.Warn("Refused a message for an unknown session or a session opened with other credentials")
+				http.Error(w, "Not Found: session not found", http.StatusNotFound)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ssePathValidationMiddleware is the pathValidationMiddleware of the SSE mode: the stream is opened
+// with GET /sse and the messages are posted to /message. OPTIONS is permitted for CORS preflight.
+func ssePathValidationMiddleware() func(http.Handler) http.Handler {
+	allowedMethods := map[string][]string{
+		sseEndpoint:        {http.MethodGet, http.MethodOptions},
+		sseMessageEndpoint: {http.MethodPost, http.MethodOptions},
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			methods, ok := allowedMethods[r.URL.Path]
+			if !ok {
+				http.Error(w, "Not Found: This server only handles requests to /sse and /message", http.StatusNotFound)
+				return
+			}
+			if !slices.Contains(methods, r.Method) {
+				w.Header().Set("Allow", strings.Join(methods, ", "))
+				http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// sseStreamMiddleware prepares the GET /sse responses, which stream the events until the client disconnects:
+// the read and write timeouts of the HTTP server, sized for the short requests, are lifted for the stream,
+// and the Access-Control-Allow-Origin decided by corsMiddleware is kept, mcp-go allows any origin otherwise.
+func sseStreamMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != sseEndpoint {
+			next.ServeHTTP(w, r)
+			return
+		}
+		rc := http.NewResponseController(w)
+		if err := rc.SetReadDeadline(time.Time{}); err != nil {
+			slog.Debug("Cannot lift the read timeout of the SSE stream", "error", err)
+		}
+		if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+			slog.Debug("Cannot lift the write timeout of the SSE stream", "error", err)
+		}
+		next.ServeHTTP(&sseResponseWriter{ResponseWriter: w, allowOrigin: w.Header().Get("Access-Control-Allow-Origin")}, r)
+	})
+}
+
+// sseResponseWriter restores the Access-Control-Allow-Origin header before the response is sent.
+type sseResponseWriter struct {
+	http.ResponseWriter
+	allowOrigin string
+	wroteHeader bool
+}
+
+func (w *sseResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if w.allowOrigin == "" {
+			w.Header().Del("Access-Control-Allow-Origin")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", w.allowOrigin)
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *sseResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *sseResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if err := http.NewResponseController(w.ResponseWriter).Flush(); err != nil {
+		slog.Debug("Cannot flush the SSE stream", "error", err)
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying response writer.
+func (w *sseResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
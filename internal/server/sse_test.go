@@ -0,0 +1,139 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/config"
+)
+
+func TestSSEPathValidationMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected int
+	}{
+		{name: "stream", method: http.MethodGet, path: "/sse", expected: http.StatusOK},
+		{name: "message", method: http.MethodPost, path: "/message", expected: http.StatusOK},
+		{name: "stream preflight", method: http.MethodOptions, path: "/sse", expected: http.StatusOK},
+		{name: "message preflight", method: http.MethodOptions, path: "/message", expected: http.StatusOK},
+		{name: "post to stream", method: http.MethodPost, path: "/sse", expected: http.StatusMethodNotAllowed},
+		{name: "get message", method: http.MethodGet, path: "/message", expected: http.StatusMethodNotAllowed},
+		{name: "streamable HTTP path", method: http.MethodPost, path: "/mcp", expected: http.StatusNotFound},
+		{name: "other path", method: http.MethodGet, path: "/other", expected: http.StatusNotFound},
+	}
+	handler := ssePathValidationMiddleware()(mockHandler())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			if rec.Code != tt.expected {
+				t.Errorf("Expected status %d for %s %s, got %d", tt.expected, tt.method, tt.path, rec.Code)
+			}
+		})
+	}
+}
+
+func TestSSEStreamMiddleware_KeepsAllowOrigin(t *testing.T) {
+	// mcp-go allows any origin on the stream
+	stream := sseStreamMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		_, _ = w.Write([]byte("event: endpoint\n\n"))
+		w.(http.Flusher).Flush()
+	}))
+
+	t.Run("CORS disabled", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		stream.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sse", nil))
+		if origin := rec.Header().Get("Access-Control-Allow-Origin"); origin != "" {
+			t.Errorf("Expected no Access-Control-Allow-Origin, got %q", origin)
+		}
+		if !rec.Flushed {
+			t.Error("Expected the stream to be flushed")
+		}
+	})
+
+	t.Run("allowed origin", func(t *testing.T) {
+		handler := corsMiddleware([]string{"http://example.com"}, "")(stream)
+		req := httptest.NewRequest(http.MethodGet, "/sse", nil)
+		req.Header.Set("Origin", "http://example.com")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if origin := rec.Header().Get("Access-Control-Allow-Origin"); origin != "http://example.com" {
+			t.Errorf("Expected Access-Control-Allow-Origin http://example.com, got %q", origin)
+		}
+	})
+}
+
+func TestSSESessionOwnerMiddleware(t *testing.T) {
+	owners := newSSESessionOwners()
+	owners.register(auth.WithBasicAuth(context.Background(), "neo4j", "password"), &loggingSession{})
+	handler := owners.sessionOwnerMiddleware(mockHandler())
+
+	tests := []struct {
+		name      string
+		user      string
+		password  string
+		sessionID string
+		expected  int
+	}{
+		{name: "owner", user: "neo4j", password: "password", sessionID: "logging-test", expected: http.StatusOK},
+		{name: "other credentials", user: "other", password: "password", sessionID: "logging-test", expected: http.StatusNotFound},
+		{name: "same user with a wrong password", user: "neo4j", password: "wrong", sessionID: "logging-test", expected: http.StatusNotFound},
+		{name: "unknown session", user: "neo4j", password: "password", sessionID: "unknown", expected: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/message?sessionId="+tt.sessionID, nil)
+			req = req.WithContext(auth.WithBasicAuth(req.Context(), tt.user, tt.password))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.expected {
+				t.Errorf("Expected status %d, got %d", tt.expected, rec.Code)
+			}
+		})
+	}
+
+	t.Run("closed stream", func(t *testing.T) {
+		owners.unregister(context.Background(), &loggingSession{})
+		req := httptest.NewRequest(http.MethodPost, "/message?sessionId=logging-test", nil)
+		req = req.WithContext(auth.WithBasicAuth(req.Context(), "neo4j", "password"))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected status 404 once the stream is closed, got %d", rec.Code)
+		}
+	})
+}
+
+func TestChainMiddleware_SSEMode(t *testing.T) {
+	mockServer := mockNeo4jMCPServer(t)
+	mockServer.config.TransportMode = config.TransportModeSSE
+	mockServer.sseSessions = newSSESessionOwners()
+	handler := mockServer.chainMiddleware([]string{"*"}, mockHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/sse", nil)
+	req.SetBasicAuth("user", "pass")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for GET /sse, got %d", rec.Code)
+	}
+	if methods := rec.Header().Get("Access-Control-Allow-Methods"); methods != "POST, OPTIONS, GET" {
+		t.Errorf("Expected GET in Access-Control-Allow-Methods, got %q", methods)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/sse", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 for GET /sse without credentials, got %d", rec.Code)
+	}
+}
@@ -4,6 +4,7 @@
 package server_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -27,7 +28,6 @@ func TestToolRegister(t *testing.T) {
 
 	t.Run("verifies expected tools are registered", func(t *testing.T) {
 		mockDB := getMockedDBService(ctrl, true)
-		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "CALL dbms.components()", gomock.Any()).Times(1)
 		cfg := &config.Config{
 			URI:           "bolt://test-host:7687",
 			Username:      "neo4j",
@@ -39,8 +39,8 @@ func TestToolRegister(t *testing.T) {
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		// Current tools: get-schema, read-cypher, write-cypher, list-gds-procedures
-		expectedTotalToolsCount := 4
+		// Current tools: get-schema, get-graph-stats, list-databases, get-indexes-and-constraints, read-cypher, explain-cypher, profile-cypher, vector-search, fulltext-search, get-neighborhood, find-paths, write-cypher, list-gds-procedures
+		expectedTotalToolsCount := 13
 
 		// Start server and register tools
 		err := s.Start()
@@ -54,9 +54,42 @@ func TestToolRegister(t *testing.T) {
 		}
 	})
 
+	t.Run("every tool declares an output schema", func(t *testing.T) {
+		mockDB := getMockedDBService(ctrl, true)
+		cfg := &config.Config{
+			URI:           "bolt://test-host:7687",
+			Username:      "neo4j",
+			Password:      "password",
+			Database:      "neo4j",
+			TransportMode: config.TransportModeStdio,
+		}
+		s := server.NewNeo4jMCPServer("test-version", cfg, mockDB, aService)
+		if err := s.Start(); err != nil {
+			t.Fatalf("Start() failed: %v", err)
+		}
+
+		for name, tool := range s.MCPServer.ListTools() {
+			data, err := json.Marshal(tool.Tool)
+			if err != nil {
+				t.Fatalf("Failed to marshal %s: %v", name, err)
+			}
+			var declared struct {
+				OutputSchema struct {
+					Type       string         `json:"type"`
+					Properties map[string]any `json:"properties"`
+				} `json:"outputSchema"`
+			}
+			if err := json.Unmarshal(data, &declared); err != nil {
+				t.Fatalf("Failed to unmarshal %s: %v", name, err)
+			}
+			if declared.OutputSchema.Type != "object" || len(declared.OutputSchema.Properties) == 0 {
+				t.Errorf("Expected %s to declare an object output schema, got %s", name, data)
+			}
+		}
+	})
+
 	t.Run("should register only readonly tools when readonly", func(t *testing.T) {
 		mockDB := getMockedDBService(ctrl, true)
-		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "CALL dbms.components()", gomock.Any()).Times(1)
 		cfg := &config.Config{
 			URI:           "bolt://test-host:7687",
 			Username:      "neo4j",
@@ -69,8 +102,8 @@ func TestToolRegister(t *testing.T) {
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		// Readonly tools: get-schema, read-cypher, list-gds-procedures
-		expectedTotalToolsCount := 3
+		// Readonly tools: get-schema, get-graph-stats, list-databases, get-indexes-and-constraints, read-cypher, explain-cypher, profile-cypher, vector-search, fulltext-search, get-neighborhood, find-paths, list-gds-procedures
+		expectedTotalToolsCount := 12
 
 		// Start server and register tools
 		err := s.Start()
@@ -85,7 +118,6 @@ func TestToolRegister(t *testing.T) {
 	})
 	t.Run("should register also not write tools when readonly is set to false", func(t *testing.T) {
 		mockDB := getMockedDBService(ctrl, true)
-		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "CALL dbms.components()", gomock.Any()).Times(1)
 		cfg := &config.Config{
 			URI:           "bolt://test-host:7687",
 			Username:      "neo4j",
@@ -98,8 +130,8 @@ func TestToolRegister(t *testing.T) {
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		// All tools: get-schema, read-cypher, write-cypher, list-gds-procedures
-		expectedTotalToolsCount := 4
+		// All tools: get-schema, get-graph-stats, list-databases, get-indexes-and-constraints, read-cypher, explain-cypher, profile-cypher, vector-search, fulltext-search, get-neighborhood, find-paths, write-cypher, list-gds-procedures
+		expectedTotalToolsCount := 13
 
 		// Start server and register tools
 		err := s.Start()
@@ -115,7 +147,6 @@ func TestToolRegister(t *testing.T) {
 
 	t.Run("should remove GDS tools if GDS is not present", func(t *testing.T) {
 		mockDB := getMockedDBService(ctrl, false)
-		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "CALL dbms.components()", gomock.Any()).Times(1)
 		cfg := &config.Config{
 			URI:           "bolt://test-host:7687",
 			Username:      "neo4j",
@@ -128,8 +159,8 @@ func TestToolRegister(t *testing.T) {
 
 		// Expected tools that should be registered
 		// update this number when a tool is added or removed.
-		// Non-GDS tools: get-schema, read-cypher, write-cypher
-		expectedTotalToolsCount := 3
+		// Non-GDS tools: get-schema, get-graph-stats, list-databases, get-indexes-and-constraints, read-cypher, explain-cypher, profile-cypher, vector-search, fulltext-search, get-neighborhood, find-paths, write-cypher
+		expectedTotalToolsCount := 12
 
 		// Start server and register tools
 		err := s.Start()
@@ -147,6 +178,7 @@ func TestToolRegister(t *testing.T) {
 // utility to mock the invocation required by VerifyRequirements
 func getMockedDBService(ctrl *gomock.Controller, withGDS bool) *db.MockService {
 	mockDB := db.NewMockService(ctrl)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "CALL dbms.components()", gomock.Any()).Times(1)
 	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), "RETURN 1 as first", gomock.Any()).Times(1).Return([]*neo4j.Record{
 		{
 			Keys: []string{"first"},
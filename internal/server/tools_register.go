@@ -5,9 +5,11 @@ package server
 
 import (
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/mcp/internal/tools"
 	"github.com/neo4j/mcp/internal/tools/cypher"
 	"github.com/neo4j/mcp/internal/tools/gds"
+	"github.com/neo4j/mcp/internal/tools/settings"
 )
 
 // registerTools registers all enabled MCP tools and adds them to the provided MCP server.
@@ -27,36 +29,55 @@ type toolFilter func(tools []ToolDefinition) []ToolDefinition
 type toolCategory int
 
 const (
-	cypherCategory toolCategory = 0
-	gdsCategory    toolCategory = 1
+	cypherCategory  toolCategory = 0
+	gdsCategory     toolCategory = 1
+	sessionCategory toolCategory = 2 // tools of the stateful HTTP sessions
 )
 
 type ToolDefinition struct {
 	category   toolCategory
 	definition server.ServerTool
 	readonly   bool
+	// usesGDS marks a non GDS tool with features enabled only when GDS is installed,
+	// it is registered again once GDS is detected so its handler sees the GDS installation.
+	usesGDS bool
+	// usesAPOC marks a tool preferring APOC when installed, it is registered again once APOC is detected.
+	usesAPOC bool
+	// requiresVectorIndexes marks a tool registered only when the Neo4j version supports vector indexes.
+	requiresVectorIndexes bool
 }
 
-func (s *Neo4jMCPServer) addGDSTools() {
+// newToolDependencies builds the dependencies shared by every tool handler.
+func (s *Neo4jMCPServer) newToolDependencies() *tools.ToolDependencies {
 	deps := &tools.ToolDependencies{
 		DBService:        s.dbService,
 		AnalyticsService: s.anService,
+		GDSInstalled:     s.capabilities.gds,
+		APOCInstalled:    s.capabilities.apoc,
 	}
-	toolDefs := s.getAllToolsDefs(deps)
-	toolDefinition := make([]server.ServerTool, 0)
-	GDSTools := make([]ToolDefinition, 0, len(toolDefs))
-	for _, t := range toolDefs {
-		if t.category == gdsCategory {
-			GDSTools = append(GDSTools, t)
-		}
-	}
-	for _, toolDef := range GDSTools {
-		toolDefinition = append(toolDefinition, toolDef.definition)
+	if s.config != nil {
+		deps.AllowedDatabases = s.config.TargetableDatabases()
+		deps.SchemaRichOutput = s.config.SchemaRichOutput
+		deps.MaxResultRows = int(s.config.MaxResultRows)
+		deps.WriteConfirmationThreshold = int(s.config.WriteConfirmationThreshold)
+		deps.DenyUnconfirmedWrites = s.config.WriteConfirmationFallback == config.WriteConfirmationFallbackDeny
 	}
-	s.MCPServer.AddTools(toolDefinition...)
+	return deps
 }
 
 func (s *Neo4jMCPServer) getEnabledTools() []server.ServerTool {
+	deps := s.newToolDependencies()
+	toolDefs := s.filterTools(s.getAllToolsDefs(deps), s.capabilities)
+
+	enabledTools := make([]server.ServerTool, 0)
+	for _, toolDef := range toolDefs {
+		enabledTools = append(enabledTools, toolDef.definition)
+	}
+	return enabledTools
+}
+
+// filterTools returns the tools enabled by the configuration and the capabilities.
+func (s *Neo4jMCPServer) filterTools(toolDefs []ToolDefinition, caps capabilities) []ToolDefinition {
 	filters := make([]toolFilter, 0)
 
 	// If read-only mode is enabled, expose only tools annotated as read-only.
@@ -64,23 +85,22 @@ func (s *Neo4jMCPServer) getEnabledTools() []server.ServerTool {
 		filters = append(filters, filterWriteTools)
 	}
 	// If GDS is not installed, disable GDS tools.
-	if !s.gdsInstalled {
+	if !caps.gds {
 		filters = append(filters, filterGDSTools)
 	}
-	deps := &tools.ToolDependencies{
-		DBService:        s.dbService,
-		AnalyticsService: s.anService,
+	// If the Neo4j version has no vector indexes, disable the tools querying them.
+	if !caps.vectorIndexes {
+		filters = append(filters, filterVectorTools)
+	}
+	// Without stateful HTTP sessions, disable the session tools.
+	if s.sessions == nil {
+		filters = append(filters, filterSessionTools)
 	}
-	toolDefs := s.getAllToolsDefs(deps)
 
 	for _, filter := range filters {
 		toolDefs = filter(toolDefs)
 	}
-	enabledTools := make([]server.ServerTool, 0)
-	for _, toolDef := range toolDefs {
-		enabledTools = append(enabledTools, toolDef.definition)
-	}
-	return enabledTools
+	return toolDefs
 }
 
 func filterWriteTools(tools []ToolDefinition) []ToolDefinition {
@@ -103,6 +123,26 @@ func filterGDSTools(tools []ToolDefinition) []ToolDefinition {
 	return nonGDSTools
 }
 
+func filterVectorTools(tools []ToolDefinition) []ToolDefinition {
+	nonVectorTools := make([]ToolDefinition, 0, len(tools))
+	for _, t := range tools {
+		if !t.requiresVectorIndexes {
+			nonVectorTools = append(nonVectorTools, t)
+		}
+	}
+	return nonVectorTools
+}
+
+func filterSessionTools(tools []ToolDefinition) []ToolDefinition {
+	nonSessionTools := make([]ToolDefinition, 0, len(tools))
+	for _, t := range tools {
+		if t.category != sessionCategory {
+			nonSessionTools = append(nonSessionTools, t)
+		}
+	}
+	return nonSessionTools
+}
+
 // getAllToolsDefs returns all available tools with their specs and handlers
 func (s *Neo4jMCPServer) getAllToolsDefs(deps *tools.ToolDependencies) []ToolDefinition {
 
@@ -111,7 +151,32 @@ func (s *Neo4jMCPServer) getAllToolsDefs(deps *tools.ToolDependencies) []ToolDef
 			category: cypherCategory,
 			definition: server.ServerTool{
 				Tool:    cypher.GetSchemaSpec(),
-				Handler: cypher.GetSchemaHandler(deps, s.config.SchemaSampleSize),
+				Handler: cypher.GetSchemaHandler(deps, s.config.SchemaSampleSize, s.schemaCache),
+			},
+			readonly: true,
+			usesAPOC: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.GetGraphStatsSpec(),
+				Handler: cypher.GetGraphStatsHandler(deps),
+			},
+			readonly: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.ListDatabasesSpec(),
+				Handler: cypher.ListDatabasesHandler(deps),
+			},
+			readonly: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.GetIndexesAndConstraintsSpec(),
+				Handler: cypher.GetIndexesAndConstraintsHandler(deps),
 			},
 			readonly: true,
 		},
@@ -123,11 +188,61 @@ func (s *Neo4jMCPServer) getAllToolsDefs(deps *tools.ToolDependencies) []ToolDef
 			},
 			readonly: true,
 		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.ExplainCypherSpec(),
+				Handler: cypher.ExplainCypherHandler(deps),
+			},
+			readonly: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.ProfileCypherSpec(),
+				Handler: cypher.ProfileCypherHandler(deps),
+			},
+			readonly: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.VectorSearchSpec(),
+				Handler: cypher.VectorSearchHandler(deps),
+			},
+			readonly:              true,
+			requiresVectorIndexes: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.FulltextSearchSpec(),
+				Handler: cypher.FulltextSearchHandler(deps),
+			},
+			readonly: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.GetNeighborhoodSpec(),
+				Handler: cypher.GetNeighborhoodHandler(deps),
+			},
+			readonly: true,
+		},
+		{
+			category: cypherCategory,
+			definition: server.ServerTool{
+				Tool:    cypher.FindPathsSpec(),
+				Handler: cypher.FindPathsHandler(deps),
+			},
+			readonly: true,
+			usesGDS:  true,
+		},
 		{
 			category: cypherCategory,
 			definition: server.ServerTool{
 				Tool:    cypher.WriteCypherSpec(),
-				Handler: cypher.WriteCypherHandler(deps),
+				Handler: cypher.WriteCypherHandler(deps, s.schemaCache),
 			},
 			readonly: false,
 		},
@@ -140,6 +255,16 @@ func (s *Neo4jMCPServer) getAllToolsDefs(deps *tools.ToolDependencies) []ToolDef
 			},
 			readonly: true,
 		},
+		// Session Category/Section
+		{
+			category: sessionCategory,
+			definition: server.ServerTool{
+				Tool:    settings.SessionSettingsSpec(),
+				Handler: settings.SessionSettingsHandler(deps),
+			},
+			// It changes the session settings, never the database
+			readonly: true,
+		},
 		// Add other categories below...
 	}
 }
@@ -0,0 +1,132 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+// Package session keeps the state of the stateful streamable-HTTP sessions: the settings the tools read
+// and the causal bookmarks of the queries run in a session.
+package session
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// Result formats of the text content returned by the query tools
+const (
+	ResultFormatJSON    = "json"    // indented JSON objects keyed by column, the default
+	ResultFormatCompact = "compact" // compact JSON with the columns once and the rows as arrays
+)
+
+// ValidResultFormats lists the allowed result formats
+var ValidResultFormats = []string{ResultFormatJSON, ResultFormatCompact}
+
+// Settings are the session-scoped settings of the tools, set with the session-settings tool.
+type Settings struct {
+	Database     string `json:"database" jsonschema:"The database the tools target when a call names none, empty for the default database"`
+	ResultFormat string `json:"resultFormat" jsonschema:"The format of the records in the text content of the query tools: json or compact"`
+	ReadOnly     bool   `json:"readOnly" jsonschema:"True when the tools modifying the database are refused in this session"`
+}
+
+// Session is a stateful streamable-HTTP session, identified by its Mcp-Session-Id.
+type Session struct {
+	id    string
+	owner string // the principal of the credentials that created the session, see auth.Principal
+
+	mu        sync.Mutex
+	settings  Settings
+	lastUsed  time.Time
+	bookmarks neo4j.BookmarkManager
+}
+
+func newSession(id, owner string, now time.Time) *Session {
+	return &Session{
+		id:        id,
+		owner:     owner,
+		settings:  Settings{ResultFormat: ResultFormatJSON},
+		lastUsed:  now,
+		bookmarks: neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{}),
+	}
+}
+
+// ID returns the Mcp-Session-Id of the session.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// Owner returns the principal of the credentials that created the session.
+func (s *Session) Owner() string {
+	return s.owner
+}
+
+// Settings returns a copy of the session settings.
+func (s *Session) Settings() Settings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.settings
+}
+
+// UpdateSettings applies update to the session settings, atomically.
+func (s *Session) UpdateSettings(update func(*Settings)) Settings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	update(&s.settings)
+	return s.settings
+}
+
+// BookmarkManager returns the bookmark manager of the session, every query of the session uses it
+// so a query sees the changes of the queries run before it in the same session, even on a cluster.
+func (s *Session) BookmarkManager() neo4j.BookmarkManager {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bookmarks
+}
+
+// Bookmarks returns the causal bookmarks of the session, sorted.
+func (s *Session) Bookmarks(ctx context.Context) ([]string, error) {
+	bookmarks, err := s.BookmarkManager().GetBookmarks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bookmarks = append([]string{}, bookmarks...)
+	slices.Sort(bookmarks)
+	return bookmarks, nil
+}
+
+// ReplaceBookmarks replaces the causal bookmarks of the session, e.g. with the bookmarks of another session
+// to see its changes. An empty list drops them.
+func (s *Session) ReplaceBookmarks(bookmarks []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bookmarks = neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{InitialBookmarks: slices.Clone(bookmarks)})
+}
+
+// touch records the session as used at now.
+func (s *Session) touch(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastUsed = now
+}
+
+func (s *Session) idleSince() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastUsed
+}
+
+type contextKey string
+
+const sessionKey contextKey = "session"
+
+// WithSession adds the session of the request to the context.
+func WithSession(ctx context.Context, session *Session) context.Context {
+	return context.WithValue(ctx, sessionKey, session)
+}
+
+// FromContext retrieves the session of the request from the context, it is not set in stateless mode.
+func FromContext(ctx context.Context) (*Session, bool) {
+	session, ok := ctx.Value(sessionKey).(*Session)
+	return session, ok && session != nil
+}
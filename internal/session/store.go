@@ -0,0 +1,111 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package session
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionExpired  = errors.New("session expired")
+	ErrStoreFull       = errors.New("too many sessions")
+)
+
+// Store keeps at most maxSessions sessions, the sessions idle for longer than idleTimeout expire.
+// When it is full, no session is created until one expires or is deleted: evicting a session
+// would let anyone with credentials push out the sessions of the other users.
+type Store struct {
+	mu          sync.Mutex
+	sessions    map[string]*Session
+	maxSessions int
+	idleTimeout time.Duration    // 0 for no expiry
+	now         func() time.Time // replaced in tests
+}
+
+// NewStore creates a session store, maxSessions must be positive.
+func NewStore(maxSessions int, idleTimeout time.Duration) *Store {
+	return &Store{
+		sessions:    make(map[string]*Session),
+		maxSessions: maxSessions,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+	}
+}
+
+// Create creates a session owned by the principal of the credentials of the initialize request.
+// It returns ErrStoreFull when the store holds maxSessions sessions.
+func (s *Store) Create(owner string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+	if len(s.sessions) >= s.maxSessions {
+		return nil, ErrStoreFull
+	}
+	session := newSession(uuid.NewString(), owner, now)
+	s.sessions[session.id] = session
+	return session, nil
+}
+
+// Full reports whether the store holds maxSessions sessions once the expired sessions are removed.
+func (s *Store) Full() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(s.now())
+	return len(s.sessions) >= s.maxSessions
+}
+
+// Get returns the session and records it as used. Expired sessions are removed.
+func (s *Store) Get(id string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	now := s.now()
+	if s.expired(session, now) {
+		delete(s.sessions, id)
+		return nil, ErrSessionExpired
+	}
+	session.touch(now)
+	return session, nil
+}
+
+// Delete removes the session, it reports whether the session existed.
+func (s *Store) Delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.sessions[id]
+	delete(s.sessions, id)
+	return ok
+}
+
+// Len returns the number of sessions, including the expired sessions not removed yet.
+func (s *Store) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sessions)
+}
+
+func (s *Store) expired(session *Session, now time.Time) bool {
+	return s.idleTimeout > 0 && now.Sub(session.idleSince()) > s.idleTimeout
+}
+
+func (s *Store) removeExpired(now time.Time) {
+	for id, session := range s.sessions {
+		if s.expired(session, now) {
+			delete(s.sessions, id)
+		}
+	}
+}
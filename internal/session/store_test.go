@@ -0,0 +1,125 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package session
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newTestStore returns a store whose clock is advanced by the returned function.
+func newTestStore(maxSessions int, idleTimeout time.Duration) (*Store, func(time.Duration)) {
+	store := NewStore(maxSessions, idleTimeout)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	return store, func(d time.Duration) { now = now.Add(d) }
+}
+
+func mustCreate(t *testing.T, store *Store, owner string) *Session {
+	t.Helper()
+	s, err := store.Create(owner)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return s
+}
+
+func TestStore(t *testing.T) {
+	t.Run("creates and retrieves sessions", func(t *testing.T) {
+		store, _ := newTestStore(10, time.Minute)
+
+		created := mustCreate(t, store, "basic:neo4j")
+		if created.ID() == "" || created.Owner() != "basic:neo4j" {
+			t.Fatalf("Unexpected session %q owned by %q", created.ID(), created.Owner())
+		}
+		got, err := store.Get(created.ID())
+		if err != nil || got != created {
+			t.Errorf("Expected the created session, got %v, %v", got, err)
+		}
+		if _, err := store.Get("unknown"); !errors.Is(err, ErrSessionNotFound) {
+			t.Errorf("Expected ErrSessionNotFound, got %v", err)
+		}
+	})
+
+	t.Run("expires the idle sessions", func(t *testing.T) {
+		store, advance := newTestStore(10, time.Minute)
+		used := mustCreate(t, store, "basic:neo4j")
+		idle := mustCreate(t, store, "basic:neo4j")
+
+		advance(40 * time.Second)
+		if _, err := store.Get(used.ID()); err != nil {
+			t.Fatalf("Expected the session to be kept, got %v", err)
+		}
+		advance(40 * time.Second)
+
+		if _, err := store.Get(idle.ID()); !errors.Is(err, ErrSessionExpired) {
+			t.Errorf("Expected ErrSessionExpired, got %v", err)
+		}
+		if _, err := store.Get(used.ID()); err != nil {
+			t.Errorf("Expected the recently used session to be kept, got %v", err)
+		}
+		if store.Len() != 1 {
+			t.Errorf("Expected the expired session to be removed, got %d sessions", store.Len())
+		}
+	})
+
+	t.Run("refuses new sessions when full", func(t *testing.T) {
+		store, advance := newTestStore(2, time.Minute)
+		first := mustCreate(t, store, "basic:alice")
+		advance(30 * time.Second)
+		second := mustCreate(t, store, "basic:alice")
+
+		if !store.Full() {
+			t.Error("Expected the store to be full")
+		}
+		if _, err := store.Create("basic:mallory"); !errors.Is(err, ErrStoreFull) {
+			t.Fatalf("Expected ErrStoreFull, got %v", err)
+		}
+		for _, s := range []*Session{first, second} {
+			if _, err := store.Get(s.ID()); err != nil {
+				t.Errorf("Expected the existing sessions to be kept, got %v", err)
+			}
+		}
+
+		store.Delete(first.ID())
+		if store.Full() {
+			t.Error("Expected the store to accept a session once one is deleted")
+		}
+		mustCreate(t, store, "basic:mallory")
+		advance(2 * time.Minute)
+		if store.Full() {
+			t.Error("Expected the store to accept sessions once they expired")
+		}
+	})
+
+	t.Run("deletes sessions", func(t *testing.T) {
+		store, _ := newTestStore(10, 0)
+		created := mustCreate(t, store, "basic:neo4j")
+
+		if !store.Delete(created.ID()) || store.Delete(created.ID()) {
+			t.Error("Expected the first delete only to find the session")
+		}
+	})
+}
+
+func TestSession_Bookmarks(t *testing.T) {
+	store, _ := newTestStore(10, 0)
+	s := mustCreate(t, store, "basic:neo4j")
+	ctx := context.Background()
+
+	s.ReplaceBookmarks([]string{"b", "a"})
+	bookmarks, err := s.Bookmarks(ctx)
+	if err != nil || !slices.Equal(bookmarks, []string{"a", "b"}) {
+		t.Errorf("Expected the sorted bookmarks, got %v, %v", bookmarks, err)
+	}
+
+	s.ReplaceBookmarks(nil)
+	bookmarks, err = s.Bookmarks(ctx)
+	if err != nil || bookmarks == nil || len(bookmarks) != 0 {
+		t.Errorf("Expected no bookmarks, got %v, %v", bookmarks, err)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+func ExplainCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleExplainCypher(ctx, request, deps)
+	}
+}
+
+func handleExplainCypher(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "Database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args ExplainCypherInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Validate that query is not empty
+	if args.Query == "" {
+		errMessage := "Query parameter is required and cannot be empty"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	slog.Info("explaining cypher query", "query", args.Query)
+
+	summary, err := deps.DBService.ExplainQuery(ctx, args.Query, args.Params)
+	if err != nil {
+		slog.Error("error explaining cypher query", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if summary.Plan() == nil {
+		errMessage := "no query plan returned for the explained query"
+		slog.Error(errMessage, "query", args.Query)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	output := QueryPlan{
+		QueryType: queryTypeName(summary.QueryType()),
+		Plan:      planToOperator(summary.Plan()),
+	}
+	jsonData, err := json.Marshal(output)
+	if err != nil {
+		slog.Error("failed to serialize query plan", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultStructured(output, string(jsonData)), nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExplainCypherHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("returns the plan tree as JSON", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExplainQuery(gomock.Any(), "MATCH (n:Person) RETURN n", gomock.Nil()).
+			Return(&fakeSummary{
+				queryType: neo4j.QueryTypeReadOnly,
+				plan: &fakePlan{
+					operator:    "ProduceResults@neo4j",
+					arguments:   map[string]any{"EstimatedRows": 10.0, "string-representation": "...", "planner": "COST"},
+					identifiers: []string{"n"},
+					children: []neo4j.Plan{
+						&fakePlan{
+							operator:    "NodeByLabelScan@neo4j",
+							arguments:   map[string]any{"EstimatedRows": 10.0, "Details": "n:Person"},
+							identifiers: []string{"n"},
+						},
+					},
+				},
+			}, nil)
+
+		handler := cypher.ExplainCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "MATCH (n:Person) RETURN n"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var plan cypher.QueryPlan
+		textContent, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			t.Fatalf("Expected text content, got %T", result.Content[0])
+		}
+		if err := json.Unmarshal([]byte(textContent.Text), &plan); err != nil {
+			t.Fatalf("Failed to unmarshal plan: %v", err)
+		}
+		if plan.QueryType != "READ_ONLY" {
+			t.Errorf("Expected query type READ_ONLY, got %s", plan.QueryType)
+		}
+		if plan.Plan.Operator != "ProduceResults@neo4j" {
+			t.Errorf("Expected root operator ProduceResults@neo4j, got %s", plan.Plan.Operator)
+		}
+		if plan.Plan.EstimatedRows == nil || *plan.Plan.EstimatedRows != 10 {
+			t.Errorf("Expected 10 estimated rows on the root operator, got %v", plan.Plan.EstimatedRows)
+		}
+		if _, ok := plan.Plan.Arguments["string-representation"]; ok {
+			t.Error("Expected string-representation to be stripped from the arguments")
+		}
+		if plan.Plan.Arguments["planner"] != "COST" {
+			t.Errorf("Expected planner argument to be preserved, got %v", plan.Plan.Arguments)
+		}
+		if len(plan.Plan.Children) != 1 || plan.Plan.Children[0].Arguments["Details"] != "n:Person" {
+			t.Errorf("Expected one NodeByLabelScan child, got %+v", plan.Plan.Children)
+		}
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+
+		handler := cypher.ExplainCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for empty query")
+		}
+	})
+
+	t.Run("explain failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExplainQuery(gomock.Any(), "MATCH (n RETURN n", gomock.Nil()).
+			Return(nil, errors.New("syntax error"))
+
+		handler := cypher.ExplainCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "MATCH (n RETURN n"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for explain failure")
+		}
+	})
+
+	t.Run("no plan returned", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExplainQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&fakeSummary{queryType: neo4j.QueryTypeReadOnly}, nil)
+
+		handler := cypher.ExplainCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "RETURN 1"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result when no plan is returned")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.ExplainCypherHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
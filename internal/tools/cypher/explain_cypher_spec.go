@@ -0,0 +1,28 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type ExplainCypherInput struct {
+	Query  string `json:"query" jsonschema:"The Cypher query to explain"`
+	Params Params `json:"params,omitempty" jsonschema:"Parameters to pass to the Cypher query"`
+}
+
+func ExplainCypherSpec() mcp.Tool {
+	return mcp.NewTool("explain-cypher",
+		mcp.WithDescription("explain-cypher returns the execution plan of a Cypher query without running it. "+
+			"The plan is a tree of operators, each with its estimated rows, identifiers and arguments, and can be used to reason about the cost of a query before executing it. "+
+			"The query is never executed, so write statements can be explained as well."),
+		mcp.WithInputSchema[ExplainCypherInput](),
+		withPlanOutputSchema[QueryPlan](),
+		mcp.WithTitleAnnotation("Explain Cypher"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,409 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	pathAlgorithmShortest    = "shortest"
+	pathAlgorithmAllShortest = "allShortest"
+	pathAlgorithmKShortest   = "kShortest"
+	pathAlgorithmWeighted    = "weighted"
+
+	defaultPathMaxLength = 5
+	maxPathMaxLength     = 15
+	defaultPathLimit     = 10
+	maxPathLimit         = 100
+
+	// pathEndpointsQuery binds the start node to a and the end node to b, the labels and the key properties
+	// are quoted by the handler so their indexes can be used
+	pathEndpointsQuery = `
+        MATCH (a:%s)
+        WHERE a.%s = $fromValue
+        WITH a LIMIT 1
+        MATCH (b:%s)
+        WHERE b.%s = $toValue
+        WITH a, b LIMIT 1
+    `
+	// unweightedPathQuery runs shortestPath or allShortestPaths from a to b, which raise an error when a is b:
+	// the path from a node to itself is that node alone
+	unweightedPathQuery = `
+        %s
+        CALL {
+            WITH a, b
+            WITH a, b WHERE a <> b
+            MATCH p = %s(%s)
+            RETURN p
+            UNION
+            WITH a, b
+            WITH a WHERE a = b
+            MATCH p = (a)
+            RETURN p
+        }
+        RETURN p
+        LIMIT $limit
+    `
+	// cypherVersionsQuery returns the Cypher versions supported by the server, Neo4j 5 does not report them
+	cypherVersionsQuery = `
+        CALL dbms.components() YIELD name, versions
+        WHERE name = 'Cypher'
+        RETURN versions
+    `
+	// weightedPathQuery projects the relationships of the requested types with a weight in a temporary GDS graph,
+	// runs Dijkstra between a and b and drops the graph, in a single statement so every step runs on the same cluster member.
+	// A path from a node to itself is that node alone, Dijkstra is not run for it, nor for the endpoints
+	// missing from the projection because they have no weighted relationship of these types: there is no path.
+	// The relationships of the path are resolved as the lowest cost relationship between consecutive nodes.
+	weightedPathQuery = `
+        MATCH (source)-[r%[1]s]->(target)
+        WHERE r[$weightProperty] IS NOT NULL
+        WITH gds.graph.project($graphName, %[2]s, {relationshipProperties: {weight: toFloat(r[$weightProperty])}}, $projectionConfig) AS graph
+        %[3]s
+        CALL {
+            WITH a, b
+            WITH a, b WHERE a <> b
+                AND EXISTS { (a)-[r%[1]s]-() WHERE r[$weightProperty] IS NOT NULL }
+                AND EXISTS { (b)-[r%[1]s]-() WHERE r[$weightProperty] IS NOT NULL }
+            CALL gds.shortestPath.dijkstra.stream($graphName, {sourceNode: a, targetNode: b, relationshipWeightProperty: 'weight', jobId: $jobId})
+            YIELD totalCost, nodeIds
+            RETURN totalCost, [nodeId IN nodeIds | gds.util.asNode(nodeId)] AS nodes
+            UNION
+            WITH a, b
+            WITH a WHERE a = b
+            RETURN 0.0 AS totalCost, [a] AS nodes
+        }
+        CALL {
+            WITH nodes
+            UNWIND range(0, size(nodes) - 2) AS i
+            WITH i, nodes[i] AS x, nodes[i + 1] AS y
+            WITH i, [%[4]s WHERE r[$weightProperty] IS NOT NULL | r] AS candidates
+            WITH i, reduce(best = head(candidates), r IN candidates | CASE WHEN r[$weightProperty] < best[$weightProperty] THEN r ELSE best END) AS relationship
+            ORDER BY i
+            RETURN collect(relationship) AS relationships
+        }
+        WITH collect({cost: totalCost, nodes: nodes, relationships: relationships}) AS paths
+        CALL gds.graph.drop($graphName, false) YIELD graphName
+        RETURN paths
+    `
+	// dropGraphQuery drops the temporary GDS graph when the weighted path query failed before dropping it
+	dropGraphQuery = `
+        CALL gds.graph.drop($graphName, false) YIELD graphName
+        RETURN graphName
+    `
+)
+
+// PathsResult is the output of the find-paths tool.
+type PathsResult struct {
+	Algorithm string      `json:"algorithm"`
+	Paths     []GraphPath `json:"paths"`
+}
+
+// FindPathsHandler returns a handler function for the find-paths tool
+func FindPathsHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleFindPaths(ctx, request, deps)
+	}
+}
+
+func handleFindPaths(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args FindPathsInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := normalizeFindPathsInput(&args); err != nil {
+		slog.Error("invalid find-paths arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Algorithm == pathAlgorithmWeighted && !deps.GDSInstalled {
+		errMessage := "the weighted algorithm requires the Graph Data Science (GDS) library, which is not installed"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	params := map[string]any{
+		"fromValue": args.From.Value,
+		"toValue":   args.To.Value,
+	}
+	endpoints := fmt.Sprintf(pathEndpointsQuery, quoteIdentifier(args.From.Label), quoteIdentifier(args.From.Key),
+		quoteIdentifier(args.To.Label), quoteIdentifier(args.To.Key))
+
+	slog.Info("finding paths", "algorithm", args.Algorithm, "maxLength", args.MaxLength)
+
+	var paths []GraphPath
+	switch args.Algorithm {
+	case pathAlgorithmWeighted:
+		// The Dijkstra job is tagged, so only its progress is reported among the jobs of the user
+		jobID := "neo4j-mcp-paths-" + uuid.NewString()
+		params["jobId"] = jobID
+		stopProgress := tools.NewProgressReporter(ctx, request).TrackGDSJob(ctx, deps.DBService, jobID)
+		paths, err = findWeightedPaths(ctx, deps.DBService, args, endpoints, params)
+		stopProgress()
+	case pathAlgorithmKShortest:
+		paths, err = findKShortestPaths(ctx, deps.DBService, args, endpoints, params)
+	default:
+		paths, err = findUnweightedPaths(ctx, deps.DBService, args, endpoints, params)
+	}
+	if err != nil {
+		slog.Error("error finding paths", "algorithm", args.Algorithm, "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	output := PathsResult{Algorithm: args.Algorithm, Paths: paths}
+	jsonData, err := json.Marshal(output)
+	if err != nil {
+		slog.Error("failed to serialize paths", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(output, string(jsonData)), nil
+}
+
+// normalizeFindPathsInput validates the arguments and applies the defaults.
+func normalizeFindPathsInput(args *FindPathsInput) error {
+	for i, ref := range []*NodeRef{&args.From, &args.To} {
+		if ref.Label == "" || ref.Key == "" || ref.Value == nil {
+			return fmt.Errorf("%s requires a label, a key and a value", []string{"from", "to"}[i])
+		}
+		if err := validateIdentifier("key", ref.Key); err != nil {
+			return err
+		}
+		// JSON numbers are bound as float64, integer properties are matched with an int64 like Params does
+		if value, ok := ref.Value.(float64); ok && value == math.Trunc(value) {
+			ref.Value = int64(value)
+		}
+	}
+	if args.Algorithm == "" {
+		args.Algorithm = pathAlgorithmShortest
+	}
+	switch args.Algorithm {
+	case pathAlgorithmShortest, pathAlgorithmAllShortest, pathAlgorithmKShortest:
+	case pathAlgorithmWeighted:
+		if args.WeightProperty == "" {
+			return fmt.Errorf("the weighted algorithm requires a weightProperty")
+		}
+		// Only the relationships of these types are projected, not every relationship of the database
+		if len(args.RelationshipTypes) == 0 {
+			return fmt.Errorf("the weighted algorithm requires relationshipTypes")
+		}
+	default:
+		return fmt.Errorf("algorithm must be one of shortest, allShortest, kShortest or weighted, got %q", args.Algorithm)
+	}
+	if args.MaxLength == 0 {
+		args.MaxLength = defaultPathMaxLength
+	}
+	if args.MaxLength < 0 || args.MaxLength > maxPathMaxLength {
+		return fmt.Errorf("maxLength must be between 1 and %d, unbounded path lengths are not allowed", maxPathMaxLength)
+	}
+	if args.Limit == 0 {
+		args.Limit = defaultPathLimit
+	}
+	if args.Limit < 0 || args.Limit > maxPathLimit {
+		return fmt.Errorf("limit must be between 1 and %d", maxPathLimit)
+	}
+	args.Direction = strings.ToUpper(args.Direction)
+	if args.Direction == "" {
+		args.Direction = "BOTH"
+	}
+	if _, ok := neighborhoodPatterns[args.Direction]; !ok {
+		return fmt.Errorf("direction must be one of OUTGOING, INCOMING or BOTH, got %q", args.Direction)
+	}
+	if args.RelationshipTypes == nil {
+		args.RelationshipTypes = []string{}
+	}
+	return nil
+}
+
+// findUnweightedPaths runs shortestPath or allShortestPaths bounded by the maximum length.
+func findUnweightedPaths(ctx context.Context, dbService database.Service, args FindPathsInput, endpoints string, params map[string]any) ([]GraphPath, error) {
+	function := "shortestPath"
+	if args.Algorithm == pathAlgorithmAllShortest {
+		function = "allShortestPaths"
+	}
+	relationship := fmt.Sprintf("%s*1..%d", relationshipTypesExpression(args.RelationshipTypes), args.MaxLength)
+	query := fmt.Sprintf(unweightedPathQuery, endpoints, function, pathPattern("a", "b", args.Direction, relationship, ""))
+	params["limit"] = args.Limit
+	return runPathQuery(ctx, dbService, query, params)
+}
+
+// findKShortestPaths runs the Cypher 25 SHORTEST k path selector bounded by the maximum length.
+func findKShortestPaths(ctx context.Context, dbService database.Service, args FindPathsInput, endpoints string, params map[string]any) ([]GraphPath, error) {
+	supported, err := supportsCypher25(ctx, dbService)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, fmt.Errorf("the kShortest algorithm requires a Neo4j server supporting Cypher 25, use shortest or allShortest instead")
+	}
+	quantifier := fmt.Sprintf("{1,%d}", args.MaxLength)
+	query := fmt.Sprintf("CYPHER 25\n%s\nMATCH p = SHORTEST %d %s\nRETURN p",
+		endpoints, args.Limit, pathPattern("a", "b", args.Direction, relationshipTypesExpression(args.RelationshipTypes), quantifier))
+	return runPathQuery(ctx, dbService, query, params)
+}
+
+// findWeightedPaths computes the lowest cost path with the GDS Dijkstra algorithm on a temporary projection
+// of the relationships of the requested types.
+func findWeightedPaths(ctx context.Context, dbService database.Service, args FindPathsInput, endpoints string, params map[string]any) ([]GraphPath, error) {
+	graphName := "neo4j-mcp-paths-" + uuid.NewString()
+	projected := "source, target"
+	projectionConfig := map[string]any{}
+	switch args.Direction {
+	case "INCOMING":
+		projected = "target, source"
+	case "BOTH":
+		projectionConfig["undirectedRelationshipTypes"] = []string{"*"}
+	}
+	types := relationshipTypesExpression(args.RelationshipTypes)
+	query := fmt.Sprintf(weightedPathQuery, types, projected, endpoints, pathPattern("x", "y", args.Direction, "r"+types, ""))
+	params["graphName"] = graphName
+	params["weightProperty"] = args.WeightProperty
+	params["projectionConfig"] = projectionConfig
+
+	records, err := dbService.ExecuteReadQuery(ctx, query, params)
+	if err != nil {
+		// The statement may have failed after the projection, the graph is dropped even if the call was cancelled
+		if _, dropErr := dbService.ExecuteReadQuery(context.WithoutCancel(ctx), dropGraphQuery, map[string]any{"graphName": graphName}); dropErr != nil {
+			slog.Warn("failed to drop the temporary GDS graph", "graphName", graphName, "error", dropErr)
+		}
+		return nil, err
+	}
+
+	paths := make([]GraphPath, 0)
+	if len(records) == 0 {
+		return paths, nil
+	}
+	raw, _ := records[0].Get("paths")
+	rawPaths, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid weighted paths returned")
+	}
+	for _, rawPath := range rawPaths {
+		path, err := weightedPathToGraphPath(rawPath)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func weightedPathToGraphPath(raw any) (GraphPath, error) {
+	fields, ok := raw.(map[string]any)
+	if !ok {
+		return GraphPath{}, fmt.Errorf("invalid weighted path returned")
+	}
+	cost, ok := toFloat64(fields["cost"])
+	if !ok {
+		return GraphPath{}, fmt.Errorf("invalid weighted path cost returned")
+	}
+	nodes, _ := fields["nodes"].([]any)
+	relationships, _ := fields["relationships"].([]any)
+	path := GraphPath{
+		Cost:          &cost,
+		Nodes:         make([]GraphNode, 0, len(nodes)),
+		Relationships: make([]GraphRelationship, 0, len(relationships)),
+	}
+	for _, rawNode := range nodes {
+		node, ok := rawNode.(neo4j.Node)
+		if !ok {
+			return GraphPath{}, fmt.Errorf("invalid weighted path node returned")
+		}
+		path.Nodes = append(path.Nodes, nodeToGraphNode(node))
+	}
+	for _, rawRelationship := range relationships {
+		relationship, ok := rawRelationship.(neo4j.Relationship)
+		if !ok {
+			return GraphPath{}, fmt.Errorf("invalid weighted path relationship returned")
+		}
+		path.Relationships = append(path.Relationships, relationshipToGraphRelationship(relationship))
+	}
+	path.Length = len(path.Relationships)
+	return path, nil
+}
+
+// runPathQuery runs a query returning a path in the p column and converts the paths.
+func runPathQuery(ctx context.Context, dbService database.Service, query string, params map[string]any) ([]GraphPath, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]GraphPath, 0, len(records))
+	for _, record := range records {
+		raw, _ := record.Get("p")
+		path, ok := raw.(neo4j.Path)
+		if !ok {
+			return nil, fmt.Errorf("invalid path returned")
+		}
+		paths = append(paths, pathToGraphPath(path))
+	}
+	return paths, nil
+}
+
+// supportsCypher25 reports whether the server lists Cypher 25 among its Cypher versions.
+func supportsCypher25(ctx context.Context, dbService database.Service) (bool, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, cypherVersionsQuery, nil)
+	if err != nil {
+		return false, err
+	}
+	for _, record := range records {
+		raw, _ := record.Get("versions")
+		versions, _ := raw.([]any)
+		if slices.Contains(versions, any("25")) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// relationshipTypesExpression returns the relationship type expression of a pattern, e.g. :KNOWS|LIKES.
+func relationshipTypesExpression(relationshipTypes []string) string {
+	if len(relationshipTypes) == 0 {
+		return ""
+	}
+	quoted := make([]string, 0, len(relationshipTypes))
+	for _, relationshipType := range relationshipTypes {
+		quoted = append(quoted, quoteIdentifier(relationshipType))
+	}
+	return ":" + strings.Join(quoted, "|")
+}
+
+// pathPattern builds the pattern between the from and to variables following the direction,
+// relationship is the content of the relationship brackets and quantifier is appended after the relationship.
+func pathPattern(from, to, direction, relationship, quantifier string) string {
+	switch direction {
+	case "OUTGOING":
+		return fmt.Sprintf("(%s)-[%s]->%s(%s)", from, relationship, quantifier, to)
+	case "INCOMING":
+		return fmt.Sprintf("(%s)<-[%s]-%s(%s)", from, relationship, quantifier, to)
+	default:
+		return fmt.Sprintf("(%s)-[%s]-%s(%s)", from, relationship, quantifier, to)
+	}
+}
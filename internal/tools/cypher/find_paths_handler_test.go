@@ -0,0 +1,337 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func queryContains(fragments ...string) func(any) bool {
+	return func(query any) bool {
+		q, ok := query.(string)
+		if !ok {
+			return false
+		}
+		for _, fragment := range fragments {
+			if !strings.Contains(q, fragment) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func findPathsRequest(arguments map[string]any) mcp.CallToolRequest {
+	arguments["from"] = map[string]any{"label": "Person", "key": "name", "value": "Alice"}
+	arguments["to"] = map[string]any{"label": "Person", "key": "id", "value": 7}
+	return mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}}
+}
+
+func decodePaths(t *testing.T, result *mcp.CallToolResult) cypher.PathsResult {
+	t.Helper()
+	if result == nil || result.IsError {
+		t.Fatalf("Expected success result, got: %v", result)
+	}
+	var output cypher.PathsResult
+	textContent := result.Content[0].(mcp.TextContent)
+	if err := json.Unmarshal([]byte(textContent.Text), &output); err != nil {
+		t.Fatalf("Failed to unmarshal output: %v", err)
+	}
+	return output
+}
+
+func TestFindPathsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	alice := testNode("4:db:1", "Person")
+	bob := testNode("4:db:2", "Person")
+	knows := testRelationship("5:db:1", "KNOWS", "4:db:1", "4:db:2")
+	path := neo4j.Path{Nodes: []neo4j.Node{alice, bob}, Relationships: []neo4j.Relationship{knows}}
+	endpointParams := map[string]any{"fromValue": "Alice", "toValue": int64(7)}
+
+	t.Run("shortest path by default", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		params := map[string]any{"limit": 10}
+		for k, v := range endpointParams {
+			params[k] = v
+		}
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("MATCH (a:`Person`)", "WHERE a.`name` = $fromValue", "WHERE b.`id` = $toValue", "shortestPath((a)-[*1..5]-(b))")), params).
+			Return([]*neo4j.Record{{Keys: []string{"p"}, Values: []any{path}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		output := decodePaths(t, result)
+		if output.Algorithm != "shortest" || len(output.Paths) != 1 {
+			t.Fatalf("Unexpected output %+v", output)
+		}
+		if output.Paths[0].Length != 1 || output.Paths[0].Relationships[0].Type != "KNOWS" || output.Paths[0].Cost != nil {
+			t.Errorf("Unexpected path %+v", output.Paths[0])
+		}
+	})
+
+	t.Run("all shortest paths with types and direction", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("allShortestPaths((a)-[:`KNOWS`|`WORKS_WITH`*1..3]->(b))")), gomock.Any()).
+			Return([]*neo4j.Record{{Keys: []string{"p"}, Values: []any{path}}, {Keys: []string{"p"}, Values: []any{path}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm":         "allShortest",
+			"maxLength":         3,
+			"direction":         "OUTGOING",
+			"relationshipTypes": []any{"KNOWS", "WORKS_WITH"},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if output := decodePaths(t, result); len(output.Paths) != 2 {
+			t.Errorf("Expected 2 paths, got %d", len(output.Paths))
+		}
+	})
+
+	t.Run("shortest path from a node to itself", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("WHERE a <> b", "shortestPath((a)-[*1..5]-(b))", "WITH a WHERE a = b", "MATCH p = (a)")), gomock.Any()).
+			Return([]*neo4j.Record{{Keys: []string{"p"}, Values: []any{neo4j.Path{Nodes: []neo4j.Node{alice}}}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		output := decodePaths(t, result)
+		if len(output.Paths) != 1 || len(output.Paths[0].Nodes) != 1 || output.Paths[0].Length != 0 {
+			t.Errorf("Expected the path made of the node alone, got %+v", output)
+		}
+	})
+
+	t.Run("k shortest paths with Cypher 25", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("dbms.components()")), gomock.Nil()).
+			Return([]*neo4j.Record{{Keys: []string{"versions"}, Values: []any{[]any{"5", "25"}}}}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("CYPHER 25", "SHORTEST 3 (a)<-[]-{1,5}(b)")), endpointParams).
+			Return([]*neo4j.Record{{Keys: []string{"p"}, Values: []any{path}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm": "kShortest",
+			"limit":     3,
+			"direction": "incoming",
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if output := decodePaths(t, result); output.Algorithm != "kShortest" || len(output.Paths) != 1 {
+			t.Errorf("Unexpected output %+v", output)
+		}
+	})
+
+	t.Run("k shortest paths without Cypher 25", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("dbms.components()")), gomock.Nil()).
+			Return([]*neo4j.Record{}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{"algorithm": "kShortest"}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result when Cypher 25 is not supported")
+		}
+	})
+
+	t.Run("weighted path with GDS", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("(source)-[r:`ROAD`]->(target)", "gds.graph.project($graphName, source, target", "WHERE a <> b", "jobId: $jobId", "WHERE a = b", "gds.graph.drop", "(x)-[r:`ROAD`]-(y)")), gomock.Cond(func(params any) bool {
+				p := params.(map[string]any)
+				graphName, _ := p["graphName"].(string)
+				config, _ := p["projectionConfig"].(map[string]any)
+				jobID, _ := p["jobId"].(string)
+				return strings.HasPrefix(graphName, "neo4j-mcp-paths-") && strings.HasPrefix(jobID, "neo4j-mcp-paths-") &&
+					p["weightProperty"] == "distance" && config["undirectedRelationshipTypes"] != nil
+			})).
+			Return([]*neo4j.Record{{Keys: []string{"paths"}, Values: []any{[]any{
+				map[string]any{"cost": 4.5, "nodes": []any{alice, bob}, "relationships": []any{knows}},
+			}}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB, GDSInstalled: true})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm":         "weighted",
+			"weightProperty":    "distance",
+			"relationshipTypes": []any{"ROAD"},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		output := decodePaths(t, result)
+		if len(output.Paths) != 1 || output.Paths[0].Cost == nil || *output.Paths[0].Cost != 4.5 || output.Paths[0].Length != 1 {
+			t.Errorf("Unexpected output %+v", output)
+		}
+	})
+
+	t.Run("weighted path from a node to itself", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("gds.shortestPath.dijkstra.stream")), gomock.Any()).
+			Return([]*neo4j.Record{{Keys: []string{"paths"}, Values: []any{[]any{
+				map[string]any{"cost": 0.0, "nodes": []any{alice}, "relationships": []any{}},
+			}}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB, GDSInstalled: true})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm":         "weighted",
+			"weightProperty":    "distance",
+			"relationshipTypes": []any{"ROAD"},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		output := decodePaths(t, result)
+		if len(output.Paths) != 1 || len(output.Paths[0].Nodes) != 1 || output.Paths[0].Length != 0 || *output.Paths[0].Cost != 0 {
+			t.Errorf("Expected the path made of the node alone, got %+v", output)
+		}
+	})
+
+	t.Run("weighted path between endpoints missing from the projection", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains(
+				"AND EXISTS { (a)-[r:`ROAD`]-() WHERE r[$weightProperty] IS NOT NULL }",
+				"AND EXISTS { (b)-[r:`ROAD`]-() WHERE r[$weightProperty] IS NOT NULL }",
+			)), gomock.Any()).
+			Return([]*neo4j.Record{{Keys: []string{"paths"}, Values: []any{[]any{}}}}, nil)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB, GDSInstalled: true})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm":         "weighted",
+			"weightProperty":    "distance",
+			"relationshipTypes": []any{"ROAD"},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Expected no path rather than an error, got: %v", result.Content)
+		}
+		if output := decodePaths(t, result); len(output.Paths) != 0 {
+			t.Errorf("Expected no path, got %+v", output)
+		}
+	})
+
+	t.Run("weighted path failure drops the graph", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		gomock.InOrder(
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("gds.shortestPath.dijkstra.stream")), gomock.Any()).
+				Return(nil, errors.New("node not found in graph")),
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("gds.graph.drop")), gomock.Any()).
+				Return([]*neo4j.Record{}, nil),
+		)
+
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB, GDSInstalled: true})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm":         "weighted",
+			"weightProperty":    "distance",
+			"relationshipTypes": []any{"ROAD"},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("weighted path without GDS", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), findPathsRequest(map[string]any{
+			"algorithm":         "weighted",
+			"weightProperty":    "distance",
+			"relationshipTypes": []any{"ROAD"},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result when GDS is not installed")
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{"maxLength": -1},
+			{"maxLength": 16},
+			{"limit": 101},
+			{"algorithm": "fastest"},
+			{"algorithm": "weighted"},
+			{"algorithm": "weighted", "weightProperty": "distance"},
+			{"direction": "UP"},
+		} {
+			mockDB := db.NewMockService(ctrl)
+			handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB, GDSInstalled: true})
+			result, err := handler(context.Background(), findPathsRequest(arguments))
+			if err != nil {
+				t.Errorf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Errorf("Expected error result for arguments %v", arguments)
+			}
+		}
+
+		mockDB := db.NewMockService(ctrl)
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, _ := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"from": map[string]any{"label": "Person"}}},
+		})
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for incomplete node references")
+		}
+
+		result, _ = handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{
+				"from": map[string]any{"label": "Person", "key": "name\n", "value": "Alice"},
+				"to":   map[string]any{"label": "Person", "key": "id", "value": 7},
+			}},
+		})
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for an invalid key")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.FindPathsHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
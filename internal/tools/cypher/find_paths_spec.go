@@ -0,0 +1,46 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// NodeRef identifies a node by a label and the value of a key property.
+type NodeRef struct {
+	Label string `json:"label" jsonschema:"The label of the node"`
+	Key   string `json:"key" jsonschema:"The name of a property identifying the node"`
+	Value any    `json:"value" jsonschema:"The value of the key property"`
+}
+
+type FindPathsInput struct {
+	From              NodeRef  `json:"from" jsonschema:"The start node of the paths"`
+	To                NodeRef  `json:"to" jsonschema:"The end node of the paths"`
+	Algorithm         string   `json:"algorithm,omitempty" jsonschema:"shortest (a single shortest path, the default), allShortest (all the shortest paths), kShortest (the k shortest paths, requires Cypher 25) or weighted (the lowest cost path with Dijkstra, requires GDS)"`
+	MaxLength         int      `json:"maxLength,omitempty" jsonschema:"The maximum number of relationships of a path, between 1 and 15, defaults to 5. Ignored by the weighted algorithm"`
+	Limit             int      `json:"limit,omitempty" jsonschema:"The maximum number of paths returned by allShortest and kShortest, defaults to 10"`
+	RelationshipTypes []string `json:"relationshipTypes,omitempty" jsonschema:"Only follow relationships of these types, all types are followed if empty. Required by the weighted algorithm"`
+	Direction         string   `json:"direction,omitempty" jsonschema:"The direction of the relationships to follow from the start node: OUTGOING, INCOMING or BOTH, defaults to BOTH"`
+	WeightProperty    string   `json:"weightProperty,omitempty" jsonschema:"The numeric relationship property used as cost by the weighted algorithm"`
+	Database          string   `json:"database,omitempty" jsonschema:"The database to search, defaults to the configured database. Use list-databases to discover the available databases"`
+}
+
+func FindPathsSpec() mcp.Tool {
+	return mcp.NewTool("find-paths",
+		mcp.WithDescription(`
+		Find the shortest paths between two nodes, each identified by a label and the value of a key property.
+		The algorithm is one of: shortest (a single shortest path), allShortest (every shortest path), kShortest (the k shortest paths
+		using the Cypher 25 SHORTEST k syntax) or weighted (the lowest cost path computed by the GDS Dijkstra algorithm on the weightProperty of the relationships,
+		only the relationshipTypes given are projected).
+		Unweighted paths are bounded by maxLength, unbounded path lengths are refused.
+		Each path is returned with its nodes and relationships, weighted paths also report their total cost.`),
+		mcp.WithInputSchema[FindPathsInput](),
+		mcp.WithOutputSchema[PathsResult](),
+		mcp.WithTitleAnnotation("Find Paths"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
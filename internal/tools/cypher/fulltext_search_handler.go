@@ -0,0 +1,167 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const (
+	defaultFulltextSearchLimit = 10
+	maxFulltextSearchLimit     = 100
+
+	// fulltextSearchNodesQuery queries a node full-text index, skip and limit are applied by the index
+	fulltextSearchNodesQuery = `
+        CALL db.index.fulltext.queryNodes($indexName, $query, {skip: $skip, limit: $limit})
+        YIELD node, score
+        RETURN node, score
+        ORDER BY score DESC
+    `
+	// fulltextSearchRelationshipsQuery queries a relationship full-text index, skip and limit are applied by the index
+	fulltextSearchRelationshipsQuery = `
+        CALL db.index.fulltext.queryRelationships($indexName, $query, {skip: $skip, limit: $limit})
+        YIELD relationship, score
+        RETURN relationship, score
+        ORDER BY score DESC
+    `
+)
+
+// luceneEscaper escapes the characters with a special meaning in the Lucene query syntax.
+var luceneEscaper = strings.NewReplacer(
+	`\`, `\\`, `+`, `\+`, `-`, `\-`, `&`, `\&`, `|`, `\|`, `!`, `\!`, `(`, `\(`, `)`, `\)`,
+	`{`, `\{`, `}`, `\}`, `[`, `\[`, `]`, `\]`, `^`, `\^`, `"`, `\"`, `~`, `\~`,
+	`*`, `\*`, `?`, `\?`, `:`, `\:`, `/`, `\/`,
+)
+
+// FulltextSearchResult is the output of the fulltext-search tool:
+// the full-text indexes when no index is given, the hits of the search otherwise.
+type FulltextSearchResult struct {
+	Indexes []Index            `json:"indexes,omitempty" jsonschema:"The available full-text indexes, when no indexName is given"`
+	Results *tools.QueryResult `json:"results,omitempty" jsonschema:"The hits of the search, when indexName is given"`
+}
+
+// FulltextSearchHandler returns a handler function for the fulltext-search tool
+func FulltextSearchHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleFulltextSearch(ctx, request, deps)
+	}
+}
+
+func handleFulltextSearch(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args FulltextSearchInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Limit == 0 {
+		args.Limit = defaultFulltextSearchLimit
+	}
+	if args.Limit < 0 || args.Limit > maxFulltextSearchLimit {
+		errMessage := fmt.Sprintf("limit must be between 1 and %d", maxFulltextSearchLimit)
+		slog.Error(errMessage, "limit", args.Limit)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if args.Skip < 0 {
+		errMessage := "skip cannot be negative"
+		slog.Error(errMessage, "skip", args.Skip)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	indexes, err := fetchIndexes(ctx, deps.DBService, showFulltextIndexesQuery)
+	if err != nil {
+		slog.Error("failed to retrieve full-text indexes", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.IndexName == "" {
+		jsonData, err := json.Marshal(indexes)
+		if err != nil {
+			slog.Error("failed to serialize full-text indexes", "error", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultStructured(FulltextSearchResult{Indexes: indexes}, string(jsonData)), nil
+	}
+
+	if strings.TrimSpace(args.Query) == "" {
+		errMessage := "query parameter is required and cannot be empty"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	index, err := findIndex(indexes, "full-text", args.IndexName)
+	if err != nil {
+		slog.Error("full-text index not found", "index", args.IndexName)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if index.State != "ONLINE" {
+		errMessage := fmt.Sprintf("full-text index %q is not online, current state is %s", index.Name, index.State)
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	query := fulltextSearchNodesQuery
+	if index.EntityType == "RELATIONSHIP" {
+		query = fulltextSearchRelationshipsQuery
+	}
+
+	searchText := args.Query
+	if !args.Lucene {
+		searchText = escapeLucene(searchText)
+	}
+
+	slog.Info("searching full-text index", "index", index.Name, "query", searchText)
+
+	records, err := deps.DBService.ExecuteReadQuery(ctx, query, map[string]any{
+		"indexName": index.Name,
+		"query":     searchText,
+		"skip":      args.Skip,
+		"limit":     args.Limit,
+	})
+	if err != nil {
+		slog.Error("error executing full-text search", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result, records := deps.NewQueryResult(records)
+	response, err := deps.FormatRecords(ctx, records)
+	if err != nil {
+		slog.Error("error formatting full-text search results", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultStructured(FulltextSearchResult{Results: result}, response), nil
+}
+
+// escapeLucene escapes the Lucene special characters so the text is searched as is.
+// The boolean keywords AND, OR and NOT are lowercased as Lucene only treats the uppercase form as an operator.
+func escapeLucene(text string) string {
+	words := strings.Fields(luceneEscaper.Replace(text))
+	for i, word := range words {
+		switch word {
+		case "AND", "OR", "NOT":
+			words[i] = strings.ToLower(word)
+		}
+	}
+	return strings.Join(words, " ")
+}
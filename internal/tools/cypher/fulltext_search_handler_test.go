@@ -0,0 +1,242 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func isShowFulltextIndexes(query any) bool {
+	q, ok := query.(string)
+	return ok && strings.Contains(q, "SHOW INDEXES") && strings.Contains(q, "type = 'FULLTEXT'")
+}
+
+func fulltextIndexRecords() []*neo4j.Record {
+	keys := []string{"name", "type", "entityType", "labelsOrTypes", "properties", "state", "populationPercent", "owningConstraint", "indexConfig"}
+	return []*neo4j.Record{
+		{
+			Keys: keys,
+			Values: []any{"movie_titles", "FULLTEXT", "NODE", []any{"Movie"}, []any{"title", "tagline"}, "ONLINE", 100.0, nil,
+				map[string]any{"fulltext.analyzer": "standard-no-stop-words"}},
+		},
+		{
+			Keys: keys,
+			Values: []any{"review_texts", "FULLTEXT", "RELATIONSHIP", []any{"REVIEWED"}, []any{"summary"}, "ONLINE", 100.0, nil,
+				map[string]any{"fulltext.analyzer": "english"}},
+		},
+	}
+}
+
+func TestFulltextSearchHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("lists full-text indexes without index name", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowFulltextIndexes), gomock.Nil()).
+			Return(fulltextIndexRecords(), nil)
+
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var indexes []cypher.Index
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &indexes); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(indexes) != 2 || indexes[0].Name != "movie_titles" || indexes[1].EntityType != "RELATIONSHIP" {
+			t.Errorf("Unexpected indexes %+v", indexes)
+		}
+	})
+
+	t.Run("escapes the query and paginates", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowFulltextIndexes), gomock.Nil()).
+			Return(fulltextIndexRecords(), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "db.index.fulltext.queryNodes")
+			}), map[string]any{
+				"indexName": "movie_titles",
+				"query":     `Mission\: Impossible \- Dead Reckoning \(Part one\) and more\!`,
+				"skip":      20,
+				"limit":     10,
+			}).
+			Return([]*neo4j.Record{}, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[]`, nil)
+
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"indexName": "movie_titles",
+					"query":     "Mission: Impossible - Dead Reckoning (Part one) AND more!",
+					"skip":      20,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("lucene query on a relationship index", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowFulltextIndexes), gomock.Nil()).
+			Return(fulltextIndexRecords(), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "db.index.fulltext.queryRelationships")
+			}), map[string]any{
+				"indexName": "review_texts",
+				"query":     "summary:great~ AND NOT boring",
+				"skip":      0,
+				"limit":     5,
+			}).
+			Return([]*neo4j.Record{}, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[]`, nil)
+
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"indexName": "review_texts",
+					"query":     "summary:great~ AND NOT boring",
+					"lucene":    true,
+					"limit":     5,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("unknown index", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowFulltextIndexes), gomock.Nil()).
+			Return(fulltextIndexRecords(), nil)
+
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"indexName": "missing", "query": "matrix"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Fatalf("Expected error result, got: %v", result)
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		if !strings.Contains(textContent.Text, "available full-text indexes: movie_titles, review_texts") {
+			t.Errorf("Expected the available indexes in the error, got %q", textContent.Text)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{"indexName": "movie_titles", "limit": 500},
+			{"indexName": "movie_titles", "skip": -1},
+		} {
+			mockDB := db.NewMockService(ctrl)
+			handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+			result, err := handler(context.Background(), mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Arguments: arguments},
+			})
+			if err != nil {
+				t.Errorf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Errorf("Expected error result for arguments %v", arguments)
+			}
+		}
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowFulltextIndexes), gomock.Nil()).
+			Return(fulltextIndexRecords(), nil)
+
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"indexName": "movie_titles", "query": "  "},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for empty query")
+		}
+	})
+
+	t.Run("search failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowFulltextIndexes), gomock.Nil()).
+			Return(fulltextIndexRecords(), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("Failed to invoke procedure"))
+
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"indexName": "movie_titles", "query": "matrix"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.FulltextSearchHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type FulltextSearchInput struct {
+	IndexName string `json:"indexName,omitempty" jsonschema:"The name of the full-text index to search. If omitted, the available full-text indexes are returned instead"`
+	Query     string `json:"query,omitempty" jsonschema:"The text to search for. Lucene special characters are escaped unless lucene is true"`
+	Lucene    bool   `json:"lucene,omitempty" jsonschema:"If true, the query is passed as is and may use the Lucene query syntax (AND, OR, wildcards, fuzzy and field queries)"`
+	Limit     int    `json:"limit,omitempty" jsonschema:"The maximum number of hits to return, defaults to 10"`
+	Skip      int    `json:"skip,omitempty" jsonschema:"The number of hits to skip, use it with limit to page through the hits"`
+	Database  string `json:"database,omitempty" jsonschema:"The database to search, defaults to the configured database. Use list-databases to discover the available databases"`
+}
+
+func FulltextSearchSpec() mcp.Tool {
+	return mcp.NewTool("fulltext-search",
+		mcp.WithDescription(`
+		Search a Neo4j full-text index and return the matched nodes or relationships with their relevance score, from the most to the least relevant.
+		Call it without indexName to list the available full-text indexes with their labels or relationship types and properties.
+		The query is searched as plain text: Lucene special characters are escaped by the server. Set lucene to true to use the Lucene query syntax instead.
+		Results are paginated with limit and skip.`),
+		mcp.WithInputSchema[FulltextSearchInput](),
+		mcp.WithOutputSchema[FulltextSearchResult](),
+		mcp.WithTitleAnnotation("Full-text Search"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	graphStatsSourceCountStore = "countStore"
+	graphStatsSourceApoc       = "apoc.meta.stats"
+
+	// maxCountStorePatterns bounds the (label)-[type]-() counts read from the count store in a single query,
+	// above it the pattern counts are read from apoc.meta.stats
+	maxCountStorePatterns = 500
+
+	// tokensQuery returns every label and relationship type of the database
+	tokensQuery = `
+        CALL { CALL db.labels() YIELD label RETURN collect(label) AS labels }
+        CALL { CALL db.relationshipTypes() YIELD relationshipType RETURN collect(relationshipType) AS relationshipTypes }
+        RETURN labels, relationshipTypes
+    `
+	// apocMetaStatsQuery returns the counts computed by APOC, which also reads them from the count store
+	apocMetaStatsQuery = `
+        CALL apoc.meta.stats()
+        YIELD nodeCount, relCount, labels, relTypesCount, relTypes
+        RETURN nodeCount, relCount, labels, relTypesCount, relTypes
+    `
+)
+
+// GraphStats is the output of the get-graph-stats tool.
+// Patterns are keyed like apoc.meta.stats, e.g. (:Person)-[:ACTED_IN]->() and ()-[:ACTED_IN]->(:Movie),
+// as the count store only keeps counts with one label per pattern.
+type GraphStats struct {
+	Source            string           `json:"source"`
+	NodeCount         int64            `json:"nodeCount"`
+	RelationshipCount int64            `json:"relationshipCount"`
+	Labels            map[string]int64 `json:"labels"`
+	RelationshipTypes map[string]int64 `json:"relationshipTypes"`
+	Patterns          map[string]int64 `json:"patterns,omitempty"`
+}
+
+// GetGraphStatsHandler returns a handler function for the get-graph-stats tool
+func GetGraphStatsHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetGraphStats(ctx, request, deps)
+	}
+}
+
+func handleGetGraphStats(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args GetGraphStatsInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	slog.Info("retrieving graph statistics from the database")
+
+	stats, err := fetchGraphStats(ctx, deps.DBService)
+	if err != nil {
+		slog.Error("failed to retrieve graph statistics", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	jsonData, err := json.Marshal(stats)
+	if err != nil {
+		slog.Error("failed to serialize graph statistics", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(stats, string(jsonData)), nil
+}
+
+// fetchGraphStats reads the counts from the count store, in constant time. When the count store query fails,
+// or there are too many label and type combinations to count the patterns in one query, apoc.meta.stats is used where available.
+func fetchGraphStats(ctx context.Context, dbService database.Service) (*GraphStats, error) {
+	stats, complete, err := countStoreStats(ctx, dbService)
+	if err == nil && complete {
+		return stats, nil
+	}
+
+	apocStats, apocErr := apocGraphStats(ctx, dbService)
+	if apocErr == nil {
+		return apocStats, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// The count store statistics without the patterns are still accurate
+	slog.Warn("apoc.meta.stats is not available, pattern counts are omitted", "error", apocErr)
+	return stats, nil
+}
+
+// countStoreStats builds a single query made of count store lookups, one per label, relationship type and pattern.
+// complete is false when the patterns were skipped because of maxCountStorePatterns.
+func countStoreStats(ctx context.Context, dbService database.Service) (*GraphStats, bool, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, tokensQuery, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(records) != 1 {
+		return nil, false, fmt.Errorf("unexpected labels and relationship types result")
+	}
+	labels := recordStrings(records[0], "labels")
+	relationshipTypes := recordStrings(records[0], "relationshipTypes")
+	complete := len(labels)*len(relationshipTypes)*2 <= maxCountStorePatterns
+
+	// Labels and types are quoted in the patterns, the keys are passed as parameters
+	params := map[string]any{}
+	subqueries := []string{
+		"MATCH (n) RETURN 'nodes' AS kind, '' AS key, count(n) AS count",
+		"MATCH ()-[r]->() RETURN 'relationships' AS kind, '' AS key, count(r) AS count",
+	}
+	addCount := func(kind, pattern, key string) {
+		param := fmt.Sprintf("k%d", len(params))
+		params[param] = key
+		subqueries = append(subqueries, fmt.Sprintf("MATCH %s RETURN '%s' AS kind, $%s AS key, count(*) AS count", pattern, kind, param))
+	}
+	for _, label := range labels {
+		addCount("label", fmt.Sprintf("(:%s)", quoteIdentifier(label)), label)
+	}
+	for _, relationshipType := range relationshipTypes {
+		addCount("type", fmt.Sprintf("()-[:%s]->()", quoteIdentifier(relationshipType)), relationshipType)
+	}
+	if complete {
+		for _, label := range labels {
+			for _, relationshipType := range relationshipTypes {
+				addCount("pattern", fmt.Sprintf("(:%s)-[:%s]->()", quoteIdentifier(label), quoteIdentifier(relationshipType)),
+					fmt.Sprintf("(:%s)-[:%s]->()", label, relationshipType))
+				addCount("pattern", fmt.Sprintf("()-[:%s]->(:%s)", quoteIdentifier(relationshipType), quoteIdentifier(label)),
+					fmt.Sprintf("()-[:%s]->(:%s)", relationshipType, label))
+			}
+		}
+	}
+
+	records, err = dbService.ExecuteReadQuery(ctx, strings.Join(subqueries, "\nUNION ALL\n"), params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	stats := &GraphStats{
+		Source:            graphStatsSourceCountStore,
+		Labels:            make(map[string]int64, len(labels)),
+		RelationshipTypes: make(map[string]int64, len(relationshipTypes)),
+	}
+	if complete {
+		stats.Patterns = make(map[string]int64)
+	}
+	for _, record := range records {
+		kind, _ := recordString(record, "kind")
+		key, _ := recordString(record, "key")
+		raw, _ := record.Get("count")
+		count, ok := raw.(int64)
+		if !ok {
+			return nil, false, fmt.Errorf("invalid count returned for %s %s", kind, key)
+		}
+		switch kind {
+		case "nodes":
+			stats.NodeCount = count
+		case "relationships":
+			stats.RelationshipCount = count
+		case "label":
+			stats.Labels[key] = count
+		case "type":
+			stats.RelationshipTypes[key] = count
+		case "pattern":
+			if count > 0 {
+				stats.Patterns[key] = count
+			}
+		}
+	}
+	return stats, complete, nil
+}
+
+// apocGraphStats reads the counts with apoc.meta.stats.
+func apocGraphStats(ctx context.Context, dbService database.Service) (*GraphStats, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, apocMetaStatsQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != 1 {
+		return nil, fmt.Errorf("unexpected apoc.meta.stats result")
+	}
+	record := records[0]
+	stats := &GraphStats{
+		Source:            graphStatsSourceApoc,
+		Labels:            recordCounts(record, "labels"),
+		RelationshipTypes: recordCounts(record, "relTypesCount"),
+		Patterns:          make(map[string]int64),
+	}
+	if raw, found := record.Get("nodeCount"); found {
+		stats.NodeCount, _ = raw.(int64)
+	}
+	if raw, found := record.Get("relCount"); found {
+		stats.RelationshipCount, _ = raw.(int64)
+	}
+	// relTypes also holds the ()-[:TYPE]->() counts, already returned as relationship types
+	for pattern, count := range recordCounts(record, "relTypes") {
+		if !strings.HasPrefix(pattern, "()-") || !strings.HasSuffix(pattern, "->()") {
+			stats.Patterns[pattern] = count
+		}
+	}
+	return stats, nil
+}
+
+// recordCounts returns the value of the given column as a map of counts, non integer values are skipped.
+func recordCounts(record *neo4j.Record, key string) map[string]int64 {
+	counts := make(map[string]int64)
+	raw, found := record.Get(key)
+	if !found {
+		return counts
+	}
+	values, ok := raw.(map[string]any)
+	if !ok {
+		return counts
+	}
+	for name, value := range values {
+		if count, ok := value.(int64); ok {
+			counts[name] = count
+		}
+	}
+	return counts
+}
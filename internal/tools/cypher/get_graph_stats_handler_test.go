@@ -0,0 +1,186 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func tokensRecord(labels []any, relationshipTypes []any) []*neo4j.Record {
+	return []*neo4j.Record{{Keys: []string{"labels", "relationshipTypes"}, Values: []any{labels, relationshipTypes}}}
+}
+
+func countRecord(kind, key string, count int64) *neo4j.Record {
+	return &neo4j.Record{Keys: []string{"kind", "key", "count"}, Values: []any{kind, key, count}}
+}
+
+func apocMetaStatsRecord() []*neo4j.Record {
+	return []*neo4j.Record{{
+		Keys: []string{"nodeCount", "relCount", "labels", "relTypesCount", "relTypes"},
+		Values: []any{int64(30), int64(40),
+			map[string]any{"Person": int64(20), "Movie": int64(10)},
+			map[string]any{"ACTED_IN": int64(40)},
+			map[string]any{"(:Person)-[:ACTED_IN]->()": int64(40), "()-[:ACTED_IN]->(:Movie)": int64(40), "()-[:ACTED_IN]->()": int64(40)},
+		},
+	}}
+}
+
+func decodeGraphStats(t *testing.T, result *mcp.CallToolResult) cypher.GraphStats {
+	t.Helper()
+	if result == nil || result.IsError {
+		t.Fatalf("Expected success result, got: %v", result)
+	}
+	var stats cypher.GraphStats
+	textContent := result.Content[0].(mcp.TextContent)
+	if err := json.Unmarshal([]byte(textContent.Text), &stats); err != nil {
+		t.Fatalf("Failed to unmarshal output: %v", err)
+	}
+	return stats
+}
+
+func TestGetGraphStatsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("counts from the count store", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.labels()", "db.relationshipTypes()")), gomock.Nil()).
+			Return(tokensRecord([]any{"Person", "Movie"}, []any{"ACTED_IN"}), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains(
+				"MATCH (:`Person`) RETURN 'label' AS kind",
+				"MATCH ()-[:`ACTED_IN`]->() RETURN 'type' AS kind",
+				"MATCH (:`Person`)-[:`ACTED_IN`]->() RETURN 'pattern' AS kind",
+				"MATCH ()-[:`ACTED_IN`]->(:`Movie`) RETURN 'pattern' AS kind",
+				"UNION ALL",
+			)), gomock.Cond(func(params any) bool {
+				return len(params.(map[string]any)) == 7
+			})).
+			Return([]*neo4j.Record{
+				countRecord("nodes", "", 30),
+				countRecord("relationships", "", 40),
+				countRecord("label", "Person", 20),
+				countRecord("label", "Movie", 10),
+				countRecord("type", "ACTED_IN", 40),
+				countRecord("pattern", "(:Person)-[:ACTED_IN]->()", 40),
+				countRecord("pattern", "()-[:ACTED_IN]->(:Person)", 0),
+				countRecord("pattern", "(:Movie)-[:ACTED_IN]->()", 0),
+				countRecord("pattern", "()-[:ACTED_IN]->(:Movie)", 40),
+			}, nil)
+
+		handler := cypher.GetGraphStatsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		stats := decodeGraphStats(t, result)
+		if stats.Source != "countStore" || stats.NodeCount != 30 || stats.RelationshipCount != 40 {
+			t.Errorf("Unexpected totals %+v", stats)
+		}
+		if stats.Labels["Person"] != 20 || stats.Labels["Movie"] != 10 || stats.RelationshipTypes["ACTED_IN"] != 40 {
+			t.Errorf("Unexpected label or type counts %+v", stats)
+		}
+		if len(stats.Patterns) != 2 || stats.Patterns["()-[:ACTED_IN]->(:Movie)"] != 40 {
+			t.Errorf("Expected the non empty patterns only, got %+v", stats.Patterns)
+		}
+	})
+
+	t.Run("falls back to apoc.meta.stats when the count store query fails", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.labels()")), gomock.Nil()).
+			Return(nil, errors.New("procedure not allowed"))
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("apoc.meta.stats()")), gomock.Nil()).
+			Return(apocMetaStatsRecord(), nil)
+
+		handler := cypher.GetGraphStatsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		stats := decodeGraphStats(t, result)
+		if stats.Source != "apoc.meta.stats" || stats.NodeCount != 30 || stats.Labels["Movie"] != 10 {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+		if len(stats.Patterns) != 2 {
+			t.Errorf("Expected the unlabelled pattern to be dropped, got %+v", stats.Patterns)
+		}
+	})
+
+	t.Run("too many patterns without apoc keeps the count store totals", func(t *testing.T) {
+		labels := make([]any, 0, 30)
+		relationshipTypes := make([]any, 0, 10)
+		for i := range 30 {
+			labels = append(labels, fmt.Sprintf("L%d", i))
+		}
+		for i := range 10 {
+			relationshipTypes = append(relationshipTypes, fmt.Sprintf("T%d", i))
+		}
+
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.labels()")), gomock.Nil()).
+			Return(tokensRecord(labels, relationshipTypes), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "UNION ALL") && !strings.Contains(query.(string), "'pattern'")
+			}), gomock.Any()).
+			Return([]*neo4j.Record{countRecord("nodes", "", 5), countRecord("label", "L0", 5)}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("apoc.meta.stats()")), gomock.Nil()).
+			Return(nil, errors.New("There is no procedure with the name `apoc.meta.stats`"))
+
+		handler := cypher.GetGraphStatsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		stats := decodeGraphStats(t, result)
+		if stats.Source != "countStore" || stats.NodeCount != 5 || stats.Patterns != nil {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+	})
+
+	t.Run("count store and apoc failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(nil, errors.New("connection failed")).
+			Times(2)
+
+		handler := cypher.GetGraphStatsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.GetGraphStatsHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
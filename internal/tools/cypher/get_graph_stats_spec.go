@@ -0,0 +1,28 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type GetGraphStatsInput struct {
+	Database string `json:"database,omitempty" jsonschema:"The database to retrieve the statistics from, defaults to the configured database. Use list-databases to discover the available databases"`
+}
+
+func GetGraphStatsSpec() mcp.Tool {
+	return mcp.NewTool("get-graph-stats",
+		mcp.WithDescription(`
+		Retrieve the size of the graph without scanning it: the total number of nodes and relationships, the number of nodes per label,
+		the number of relationships per type and per pattern, e.g. (:Person)-[:ACTED_IN]->() or ()-[:ACTED_IN]->(:Movie).
+		The counts are read in constant time from the Neo4j count store, or from apoc.meta.stats for graphs with many labels and relationship types.`),
+		mcp.WithInputSchema[GetGraphStatsInput](),
+		mcp.WithOutputSchema[GraphStats](),
+		mcp.WithTitleAnnotation("Get Graph Statistics"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	// showIndexesQuery lists every index with the columns available since Neo4j 5
+	showIndexesQuery = `
+        SHOW INDEXES
+        YIELD name, type, entityType, labelsOrTypes, properties, state, populationPercent, owningConstraint, options
+        RETURN name, type, entityType, labelsOrTypes, properties, state, populationPercent, owningConstraint, options.indexConfig AS indexConfig
+        ORDER BY name
+    `
+	// showVectorIndexesQuery lists the vector indexes, with the same columns as showIndexesQuery
+	showVectorIndexesQuery = `
+        SHOW INDEXES
+        YIELD name, type, entityType, labelsOrTypes, properties, state, populationPercent, owningConstraint, options
+        WHERE type = 'VECTOR'
+        RETURN name, type, entityType, labelsOrTypes, properties, state, populationPercent, owningConstraint, options.indexConfig AS indexConfig
+        ORDER BY name
+    `
+	// showFulltextIndexesQuery lists the full-text indexes, with the same columns as showIndexesQuery
+	showFulltextIndexesQuery = `
+        SHOW INDEXES
+        YIELD name, type, entityType, labelsOrTypes, properties, state, populationPercent, owningConstraint, options
+        WHERE type = 'FULLTEXT'
+        RETURN name, type, entityType, labelsOrTypes, properties, state, populationPercent, owningConstraint, options.indexConfig AS indexConfig
+        ORDER BY name
+    `
+	// showConstraintsQuery lists every constraint with the columns available since Neo4j 5
+	showConstraintsQuery = `
+        SHOW CONSTRAINTS
+        YIELD name, type, entityType, labelsOrTypes, properties, ownedIndex
+        RETURN name, type, entityType, labelsOrTypes, properties, ownedIndex
+        ORDER BY name
+    `
+)
+
+// Index is a JSON tagged representation of a row returned by SHOW INDEXES.
+type Index struct {
+	Name              string         `json:"name"`
+	Type              string         `json:"type"`
+	EntityType        string         `json:"entityType"`
+	LabelsOrTypes     []string       `json:"labelsOrTypes,omitempty"`
+	Properties        []string       `json:"properties,omitempty"`
+	State             string         `json:"state"`
+	PopulationPercent float64        `json:"populationPercent"`
+	OwningConstraint  string         `json:"owningConstraint,omitempty"`
+	IndexConfig       map[string]any `json:"indexConfig,omitempty"`
+}
+
+// Constraint is a JSON tagged representation of a row returned by SHOW CONSTRAINTS.
+type Constraint struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	EntityType    string   `json:"entityType"`
+	LabelsOrTypes []string `json:"labelsOrTypes,omitempty"`
+	Properties    []string `json:"properties,omitempty"`
+	OwnedIndex    string   `json:"ownedIndex,omitempty"`
+}
+
+// IndexesAndConstraints is the output of the get-indexes-and-constraints tool.
+type IndexesAndConstraints struct {
+	Indexes     []Index      `json:"indexes"`
+	Constraints []Constraint `json:"constraints"`
+}
+
+// GetIndexesAndConstraintsHandler returns a handler function for the get-indexes-and-constraints tool
+func GetIndexesAndConstraintsHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetIndexesAndConstraints(ctx, deps)
+	}
+}
+
+func handleGetIndexesAndConstraints(ctx context.Context, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	slog.Info("retrieving indexes and constraints from the database")
+
+	indexes, err := fetchIndexes(ctx, deps.DBService, showIndexesQuery)
+	if err != nil {
+		slog.Error("failed to retrieve indexes", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	constraints, err := fetchConstraints(ctx, deps.DBService)
+	if err != nil {
+		slog.Error("failed to retrieve constraints", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	output := IndexesAndConstraints{
+		Indexes:     indexes,
+		Constraints: constraints,
+	}
+	jsonData, err := json.Marshal(output)
+	if err != nil {
+		slog.Error("failed to serialize indexes and constraints", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(output, string(jsonData)), nil
+}
+
+// fetchIndexes runs the given SHOW INDEXES query and converts the returned records into a list of Index.
+func fetchIndexes(ctx context.Context, dbService database.Service, query string) ([]Index, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]Index, 0, len(records))
+	for _, record := range records {
+		index, err := recordToIndex(record)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// fetchConstraints runs SHOW CONSTRAINTS and converts the returned records into a list of Constraint.
+func fetchConstraints(ctx context.Context, dbService database.Service) ([]Constraint, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, showConstraintsQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	constraints := make([]Constraint, 0, len(records))
+	for _, record := range records {
+		constraint, err := recordToConstraint(record)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints, nil
+}
+
+// findIndex returns the index with the given name, the error lists the available indexes of the kind.
+func findIndex(indexes []Index, kind string, name string) (Index, error) {
+	names := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		if index.Name == name {
+			return index, nil
+		}
+		names = append(names, index.Name)
+	}
+	if len(names) == 0 {
+		return Index{}, fmt.Errorf("%s index %q not found, the database has no %s index", kind, name, kind)
+	}
+	return Index{}, fmt.Errorf("%s index %q not found, available %s indexes: %s", kind, name, kind, strings.Join(names, ", "))
+}
+
+func recordToIndex(record *neo4j.Record) (Index, error) {
+	var index Index
+	var ok bool
+	if index.Name, ok = recordString(record, "name"); !ok {
+		return Index{}, fmt.Errorf("invalid index name returned")
+	}
+	if index.Type, ok = recordString(record, "type"); !ok {
+		return Index{}, fmt.Errorf("invalid index type returned")
+	}
+	if index.EntityType, ok = recordString(record, "entityType"); !ok {
+		return Index{}, fmt.Errorf("invalid index entity type returned")
+	}
+	if index.State, ok = recordString(record, "state"); !ok {
+		return Index{}, fmt.Errorf("invalid index state returned")
+	}
+	// LOOKUP indexes have no labels, types or properties
+	index.LabelsOrTypes = recordStrings(record, "labelsOrTypes")
+	index.Properties = recordStrings(record, "properties")
+	index.OwningConstraint, _ = recordString(record, "owningConstraint")
+	if populationPercent, found := record.Get("populationPercent"); found {
+		index.PopulationPercent, _ = toFloat64(populationPercent)
+	}
+	if indexConfig, found := record.Get("indexConfig"); found {
+		if config, ok := indexConfig.(map[string]any); ok && len(config) > 0 {
+			index.IndexConfig = config
+		}
+	}
+	return index, nil
+}
+
+func recordToConstraint(record *neo4j.Record) (Constraint, error) {
+	var constraint Constraint
+	var ok bool
+	if constraint.Name, ok = recordString(record, "name"); !ok {
+		return Constraint{}, fmt.Errorf("invalid constraint name returned")
+	}
+	if constraint.Type, ok = recordString(record, "type"); !ok {
+		return Constraint{}, fmt.Errorf("invalid constraint type returned")
+	}
+	if constraint.EntityType, ok = recordString(record, "entityType"); !ok {
+		return Constraint{}, fmt.Errorf("invalid constraint entity type returned")
+	}
+	constraint.LabelsOrTypes = recordStrings(record, "labelsOrTypes")
+	constraint.Properties = recordStrings(record, "properties")
+	constraint.OwnedIndex, _ = recordString(record, "ownedIndex")
+	return constraint, nil
+}
+
+// recordString returns the value of the given column as a string, ok is false if the column is missing or not a string.
+func recordString(record *neo4j.Record, key string) (string, bool) {
+	raw, found := record.Get(key)
+	if !found {
+		return "", false
+	}
+	value, ok := raw.(string)
+	return value, ok
+}
+
+// recordStrings returns the value of the given column as a list of strings, non string elements are skipped.
+func recordStrings(record *neo4j.Record, key string) []string {
+	raw, found := record.Get(key)
+	if !found {
+		return nil
+	}
+	rawList, ok := raw.([]any)
+	if !ok {
+		return nil
+	}
+	values := make([]string, 0, len(rawList))
+	for _, v := range rawList {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,163 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func isShowIndexes(query any) bool {
+	q, ok := query.(string)
+	return ok && strings.Contains(q, "SHOW INDEXES")
+}
+
+func isShowConstraints(query any) bool {
+	q, ok := query.(string)
+	return ok && strings.Contains(q, "SHOW CONSTRAINTS")
+}
+
+func TestGetIndexesAndConstraintsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	indexKeys := []string{"name", "type", "entityType", "labelsOrTypes", "properties", "state", "populationPercent", "owningConstraint", "indexConfig"}
+	constraintKeys := []string{"name", "type", "entityType", "labelsOrTypes", "properties", "ownedIndex"}
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowIndexes), gomock.Nil()).
+			Return([]*neo4j.Record{
+				{
+					Keys:   indexKeys,
+					Values: []any{"index_343aff4e", "LOOKUP", "NODE", nil, nil, "ONLINE", 100.0, nil, map[string]any{}},
+				},
+				{
+					Keys:   indexKeys,
+					Values: []any{"person_name", "RANGE", "NODE", []any{"Person"}, []any{"name"}, "POPULATING", 42.5, "person_name", map[string]any{}},
+				},
+				{
+					Keys: indexKeys,
+					Values: []any{"movie_embedding", "VECTOR", "NODE", []any{"Movie"}, []any{"embedding"}, "ONLINE", 100.0, nil,
+						map[string]any{"vector.dimensions": int64(1536), "vector.similarity_function": "COSINE"}},
+				},
+			}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowConstraints), gomock.Nil()).
+			Return([]*neo4j.Record{
+				{
+					Keys:   constraintKeys,
+					Values: []any{"person_name", "UNIQUENESS", "NODE", []any{"Person"}, []any{"name"}, "person_name"},
+				},
+			}, nil)
+
+		handler := cypher.GetIndexesAndConstraintsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var output cypher.IndexesAndConstraints
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &output); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(output.Indexes) != 3 {
+			t.Fatalf("Expected 3 indexes, got %d", len(output.Indexes))
+		}
+		if output.Indexes[0].LabelsOrTypes != nil || output.Indexes[0].IndexConfig != nil {
+			t.Errorf("Expected LOOKUP index without labels and config, got %+v", output.Indexes[0])
+		}
+		if output.Indexes[1].PopulationPercent != 42.5 || output.Indexes[1].OwningConstraint != "person_name" {
+			t.Errorf("Unexpected RANGE index %+v", output.Indexes[1])
+		}
+		if output.Indexes[2].IndexConfig["vector.similarity_function"] != "COSINE" {
+			t.Errorf("Expected vector index config to be returned, got %+v", output.Indexes[2])
+		}
+		if len(output.Constraints) != 1 || output.Constraints[0].OwnedIndex != "person_name" {
+			t.Errorf("Unexpected constraints %+v", output.Constraints)
+		}
+	})
+
+	t.Run("index query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowIndexes), gomock.Nil()).
+			Return(nil, errors.New("connection failed"))
+
+		handler := cypher.GetIndexesAndConstraintsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("invalid index record", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowIndexes), gomock.Nil()).
+			Return([]*neo4j.Record{
+				{
+					Keys:   indexKeys,
+					Values: []any{nil, "RANGE", "NODE", nil, nil, "ONLINE", 100.0, nil, nil},
+				},
+			}, nil)
+
+		handler := cypher.GetIndexesAndConstraintsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for invalid index record")
+		}
+	})
+
+	t.Run("constraint query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowIndexes), gomock.Nil()).
+			Return([]*neo4j.Record{}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowConstraints), gomock.Nil()).
+			Return(nil, errors.New("connection failed"))
+
+		handler := cypher.GetIndexesAndConstraintsHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.GetIndexesAndConstraintsHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
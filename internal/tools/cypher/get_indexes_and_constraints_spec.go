@@ -0,0 +1,24 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func GetIndexesAndConstraintsSpec() mcp.Tool {
+	return mcp.NewTool("get-indexes-and-constraints",
+		mcp.WithDescription(`
+		Retrieve the indexes and constraints defined in the Neo4j database.
+		For each index it returns the name, the type (RANGE, TEXT, POINT, FULLTEXT, VECTOR, LOOKUP), the state, the population progress,
+		the indexed labels or relationship types and properties. For each constraint it returns the name, the type, the constrained labels or relationship types and properties.
+		Use it to write Cypher queries that can take advantage of the existing indexes.`),
+		mcp.WithOutputSchema[IndexesAndConstraints](),
+		mcp.WithTitleAnnotation("Get Neo4j Indexes and Constraints"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	defaultNeighborhoodDepth    = 1
+	maxNeighborhoodDepth        = 3
+	defaultNeighborhoodMaxNodes = 50
+	maxNeighborhoodMaxNodes     = 500
+	// neighborhoodRowsPerNode bounds the relationships fetched for each hop, relative to the node budget
+	neighborhoodRowsPerNode = 5
+
+	// startNodeByElementIDQuery finds the start node from its element id
+	startNodeByElementIDQuery = `
+        MATCH (n)
+        WHERE elementId(n) = $elementId
+        RETURN n
+        LIMIT 1
+    `
+	// startNodeByKeyQuery finds the start node from a label and a key property, both quoted by the handler
+	// so an index on the property can be used
+	startNodeByKeyQuery = `
+        MATCH (n:%s)
+        WHERE n.%s = $value
+        RETURN n
+        LIMIT 1
+    `
+	// expandNeighborhoodQuery expands the frontier by one hop, the relationship pattern depends on the direction
+	expandNeighborhoodQuery = `
+        MATCH (n)
+        WHERE elementId(n) IN $frontier
+        MATCH %s
+        WHERE size($types) = 0 OR type(r) IN $types
+        RETURN r, m
+        LIMIT $limit
+    `
+)
+
+// neighborhoodPatterns maps each supported direction to the relationship pattern used to expand the frontier.
+var neighborhoodPatterns = map[string]string{
+	"OUTGOING": "(n)-[r]->(m)",
+	"INCOMING": "(n)<-[r]-(m)",
+	"BOTH":     "(n)-[r]-(m)",
+}
+
+// GetNeighborhoodHandler returns a handler function for the get-neighborhood tool
+func GetNeighborhoodHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetNeighborhood(ctx, request, deps)
+	}
+}
+
+func handleGetNeighborhood(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args GetNeighborhoodInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := normalizeNeighborhoodInput(&args); err != nil {
+		slog.Error("invalid get-neighborhood arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	startQuery, startParams := startNodeQuery(args)
+	records, err := deps.DBService.ExecuteReadQuery(ctx, startQuery, startParams)
+	if err != nil {
+		slog.Error("error finding the start node", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(records) == 0 {
+		errMessage := "start node not found"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	start, ok := recordNode(records[0], "n")
+	if !ok {
+		errMessage := "invalid start node returned"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	slog.Info("expanding node neighborhood", "elementId", start.ElementId, "depth", args.Depth, "direction", args.Direction)
+
+	subgraph := newSubgraph()
+	subgraph.addNode(start)
+	frontier := []string{start.ElementId}
+	expandQuery := fmt.Sprintf(expandNeighborhoodQuery, neighborhoodPatterns[args.Direction])
+	rowLimit := args.MaxNodes * neighborhoodRowsPerNode
+
+	for hop := 0; hop < args.Depth && len(frontier) > 0 && !subgraph.Truncated; hop++ {
+		records, err := deps.DBService.ExecuteReadQuery(ctx, expandQuery, map[string]any{
+			"frontier": frontier,
+			"types":    args.RelationshipTypes,
+			"limit":    rowLimit,
+		})
+		if err != nil {
+			slog.Error("error expanding node neighborhood", "error", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if len(records) >= rowLimit {
+			subgraph.Truncated = true
+		}
+
+		frontier = make([]string, 0)
+		for _, record := range records {
+			relationship, okRelationship := recordRelationship(record, "r")
+			neighbor, okNeighbor := recordNode(record, "m")
+			if !okRelationship || !okNeighbor {
+				errMessage := "invalid neighborhood record returned"
+				slog.Error(errMessage)
+				return mcp.NewToolResultError(errMessage), nil
+			}
+			if !subgraph.hasNode(neighbor.ElementId) {
+				if len(subgraph.Nodes) >= args.MaxNodes {
+					subgraph.Truncated = true
+					continue
+				}
+				subgraph.addNode(neighbor)
+				frontier = append(frontier, neighbor.ElementId)
+			}
+			subgraph.addRelationship(relationship)
+		}
+	}
+
+	jsonData, err := json.Marshal(subgraph)
+	if err != nil {
+		slog.Error("failed to serialize neighborhood", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(subgraph, string(jsonData)), nil
+}
+
+// normalizeNeighborhoodInput validates the arguments and applies the defaults.
+func normalizeNeighborhoodInput(args *GetNeighborhoodInput) error {
+	if args.ElementID == "" && (args.Label == "" || args.Key == "" || args.Value == nil) {
+		return fmt.Errorf("either elementId or label, key and value are required to identify the start node")
+	}
+	if args.ElementID == "" {
+		if err := validateIdentifier("key", args.Key); err != nil {
+			return err
+		}
+	}
+	// JSON numbers are bound as float64, integer properties are matched with an int64 like Params does
+	if value, ok := args.Value.(float64); ok && value == math.Trunc(value) {
+		args.Value = int64(value)
+	}
+	if args.Depth == 0 {
+		args.Depth = defaultNeighborhoodDepth
+	}
+	if args.Depth < 0 || args.Depth > maxNeighborhoodDepth {
+		return fmt.Errorf("depth must be between 1 and %d", maxNeighborhoodDepth)
+	}
+	if args.MaxNodes == 0 {
+		args.MaxNodes = defaultNeighborhoodMaxNodes
+	}
+	if args.MaxNodes < 0 || args.MaxNodes > maxNeighborhoodMaxNodes {
+		return fmt.Errorf("maxNodes must be between 1 and %d", maxNeighborhoodMaxNodes)
+	}
+	args.Direction = strings.ToUpper(args.Direction)
+	if args.Direction == "" {
+		args.Direction = "BOTH"
+	}
+	if _, ok := neighborhoodPatterns[args.Direction]; !ok {
+		return fmt.Errorf("direction must be one of OUTGOING, INCOMING or BOTH, got %q", args.Direction)
+	}
+	if args.RelationshipTypes == nil {
+		args.RelationshipTypes = []string{}
+	}
+	return nil
+}
+
+// startNodeQuery returns the query and parameters finding the start node of the neighborhood.
+func startNodeQuery(args GetNeighborhoodInput) (string, map[string]any) {
+	if args.ElementID != "" {
+		return startNodeByElementIDQuery, map[string]any{"elementId": args.ElementID}
+	}
+	return fmt.Sprintf(startNodeByKeyQuery, quoteIdentifier(args.Label), quoteIdentifier(args.Key)), map[string]any{
+		"value": args.Value,
+	}
+}
+
+func recordNode(record *neo4j.Record, key string) (neo4j.Node, bool) {
+	raw, found := record.Get(key)
+	if !found {
+		return neo4j.Node{}, false
+	}
+	node, ok := raw.(neo4j.Node)
+	return node, ok
+}
+
+func recordRelationship(record *neo4j.Record, key string) (neo4j.Relationship, bool) {
+	raw, found := record.Get(key)
+	if !found {
+		return neo4j.Relationship{}, false
+	}
+	relationship, ok := raw.(neo4j.Relationship)
+	return relationship, ok
+}
@@ -0,0 +1,242 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func testNode(elementID string, label string) neo4j.Node {
+	return neo4j.Node{ElementId: elementID, Labels: []string{label}, Props: map[string]any{"id": elementID}}
+}
+
+func testRelationship(elementID, relType, start, end string) neo4j.Relationship {
+	return neo4j.Relationship{ElementId: elementID, Type: relType, StartElementId: start, EndElementId: end, Props: map[string]any{}}
+}
+
+func neighborRecord(relationship neo4j.Relationship, neighbor neo4j.Node) *neo4j.Record {
+	return &neo4j.Record{Keys: []string{"r", "m"}, Values: []any{relationship, neighbor}}
+}
+
+func isExpandNeighborhood(pattern string) func(any) bool {
+	return func(query any) bool {
+		q, ok := query.(string)
+		return ok && strings.Contains(q, "$frontier") && strings.Contains(q, pattern)
+	}
+}
+
+func TestGetNeighborhoodHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	alice := testNode("4:db:1", "Person")
+	bob := testNode("4:db:2", "Person")
+	matrix := testNode("4:db:3", "Movie")
+	carol := testNode("4:db:4", "Person")
+
+	t.Run("two hops by label and key with de-duplication", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "MATCH (n:`Per``son`)") && strings.Contains(query.(string), "WHERE n.`name` = $value")
+			}), map[string]any{"value": int64(42)}).
+			Return([]*neo4j.Record{{Keys: []string{"n"}, Values: []any{alice}}}, nil)
+		gomock.InOrder(
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Cond(isExpandNeighborhood("(n)-[r]->(m)")), map[string]any{
+					"frontier": []string{"4:db:1"},
+					"types":    []string{"ACTED_IN", "KNOWS"},
+					"limit":    250,
+				}).
+				Return([]*neo4j.Record{
+					neighborRecord(testRelationship("5:db:1", "KNOWS", "4:db:1", "4:db:2"), bob),
+					neighborRecord(testRelationship("5:db:2", "ACTED_IN", "4:db:1", "4:db:3"), matrix),
+				}, nil),
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Cond(isExpandNeighborhood("(n)-[r]->(m)")), map[string]any{
+					"frontier": []string{"4:db:2", "4:db:3"},
+					"types":    []string{"ACTED_IN", "KNOWS"},
+					"limit":    250,
+				}).
+				Return([]*neo4j.Record{
+					neighborRecord(testRelationship("5:db:3", "ACTED_IN", "4:db:2", "4:db:3"), matrix),
+					neighborRecord(testRelationship("5:db:4", "KNOWS", "4:db:2", "4:db:1"), alice),
+				}, nil),
+		)
+
+		handler := cypher.GetNeighborhoodHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"label":             "Per`son",
+					"key":               "name",
+					"value":             42,
+					"depth":             2,
+					"direction":         "outgoing",
+					"relationshipTypes": []any{"ACTED_IN", "KNOWS"},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var subgraph cypher.Subgraph
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &subgraph); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(subgraph.Nodes) != 3 {
+			t.Errorf("Expected 3 nodes, got %d", len(subgraph.Nodes))
+		}
+		if len(subgraph.Relationships) != 4 {
+			t.Errorf("Expected 4 relationships, got %d", len(subgraph.Relationships))
+		}
+		if subgraph.Truncated {
+			t.Error("Expected the subgraph not to be truncated")
+		}
+		if subgraph.Relationships[0].StartElementID != "4:db:1" || subgraph.Relationships[0].Type != "KNOWS" {
+			t.Errorf("Unexpected relationship %+v", subgraph.Relationships[0])
+		}
+	})
+
+	t.Run("node budget truncates the neighborhood", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "elementId(n) = $elementId")
+			}), map[string]any{"elementId": "4:db:1"}).
+			Return([]*neo4j.Record{{Keys: []string{"n"}, Values: []any{alice}}}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isExpandNeighborhood("(n)-[r]-(m)")), map[string]any{
+				"frontier": []string{"4:db:1"},
+				"types":    []string{},
+				"limit":    10,
+			}).
+			Return([]*neo4j.Record{
+				neighborRecord(testRelationship("5:db:1", "KNOWS", "4:db:1", "4:db:2"), bob),
+				neighborRecord(testRelationship("5:db:5", "KNOWS", "4:db:4", "4:db:1"), carol),
+			}, nil)
+
+		handler := cypher.GetNeighborhoodHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"elementId": "4:db:1",
+					"depth":     3,
+					"maxNodes":  2,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var subgraph cypher.Subgraph
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &subgraph); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(subgraph.Nodes) != 2 || len(subgraph.Relationships) != 1 {
+			t.Errorf("Expected 2 nodes and 1 relationship, got %+v", subgraph)
+		}
+		if !subgraph.Truncated {
+			t.Error("Expected the subgraph to be truncated")
+		}
+	})
+
+	t.Run("start node not found", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), map[string]any{"elementId": "4:db:99"}).
+			Return([]*neo4j.Record{}, nil)
+
+		handler := cypher.GetNeighborhoodHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"elementId": "4:db:99"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a missing start node")
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{},
+			{"label": "Person", "key": "name"},
+			{"label": "Person", "key": "na\x00me", "value": 1},
+			{"elementId": "4:db:1", "depth": 4},
+			{"elementId": "4:db:1", "maxNodes": 501},
+			{"elementId": "4:db:1", "direction": "SIDEWAYS"},
+		} {
+			mockDB := db.NewMockService(ctrl)
+			handler := cypher.GetNeighborhoodHandler(&tools.ToolDependencies{DBService: mockDB})
+			result, err := handler(context.Background(), mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Arguments: arguments},
+			})
+			if err != nil {
+				t.Errorf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Errorf("Expected error result for arguments %v", arguments)
+			}
+		}
+	})
+
+	t.Run("expansion failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), map[string]any{"elementId": "4:db:1"}).
+			Return([]*neo4j.Record{{Keys: []string{"n"}, Values: []any{alice}}}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isExpandNeighborhood("(n)<-[r]-(m)")), gomock.Any()).
+			Return(nil, errors.New("connection failed"))
+
+		handler := cypher.GetNeighborhoodHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"elementId": "4:db:1", "direction": "INCOMING"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.GetNeighborhoodHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
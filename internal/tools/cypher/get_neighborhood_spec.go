@@ -0,0 +1,38 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type GetNeighborhoodInput struct {
+	ElementID         string   `json:"elementId,omitempty" jsonschema:"The elementId of the start node. Alternatively identify the start node with label, key and value"`
+	Label             string   `json:"label,omitempty" jsonschema:"The label of the start node, used together with key and value"`
+	Key               string   `json:"key,omitempty" jsonschema:"The name of a property identifying the start node, used together with label and value"`
+	Value             any      `json:"value,omitempty" jsonschema:"The value of the key property of the start node"`
+	Depth             int      `json:"depth,omitempty" jsonschema:"The number of hops to expand from the start node, between 1 and 3, defaults to 1"`
+	RelationshipTypes []string `json:"relationshipTypes,omitempty" jsonschema:"Only follow relationships of these types, all types are followed if empty"`
+	Direction         string   `json:"direction,omitempty" jsonschema:"The direction of the relationships to follow: OUTGOING, INCOMING or BOTH, defaults to BOTH"`
+	MaxNodes          int      `json:"maxNodes,omitempty" jsonschema:"The maximum number of nodes to return, including the start node, defaults to 50"`
+	Database          string   `json:"database,omitempty" jsonschema:"The database to explore, defaults to the configured database. Use list-databases to discover the available databases"`
+}
+
+func GetNeighborhoodSpec() mcp.Tool {
+	return mcp.NewTool("get-neighborhood",
+		mcp.WithDescription(`
+		Explore the graph around a node, without writing a variable-length Cypher query.
+		The start node is identified by its elementId, or by a label and the value of a key property.
+		The neighborhood is expanded breadth-first up to depth hops, following the given relationship types and direction,
+		until maxNodes nodes are collected. It returns the de-duplicated nodes and relationships of the subgraph,
+		truncated is true when the node budget stopped the expansion.`),
+		mcp.WithInputSchema[GetNeighborhoodInput](),
+		mcp.WithOutputSchema[Subgraph](),
+		mcp.WithTitleAnnotation("Get Node Neighborhood"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/neo4j/mcp/internal/tools"
@@ -21,51 +22,143 @@ const (
         YIELD value
         UNWIND keys(value) as key
         WITH key, value[key] as value
-        RETURN key, value { .properties, .type, .relationships } as value
+        RETURN key, value { .properties, .type, .relationships, .count } as value
     `
 )
 
-// GetSchemaHandler returns a handler function for the get_schema tool
-func GetSchemaHandler(deps *tools.ToolDependencies, schemaSampleSize int32) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleGetSchema(ctx, deps, schemaSampleSize)
+// GetSchemaHandler returns a handler function for the get_schema tool, a nil cache reads the schema on every call
+func GetSchemaHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetSchema(ctx, request, deps, schemaSampleSize, cache)
 	}
 }
 
-// handleGetSchema retrieves Neo4j schema information using APOC
-func handleGetSchema(ctx context.Context, deps *tools.ToolDependencies, schemaSampleSize int32) (*mcp.CallToolResult, error) {
+// handleGetSchema retrieves Neo4j schema information using APOC, or the core schema procedures when APOC is not installed
+func handleGetSchema(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache) (*mcp.CallToolResult, error) {
 	if deps.DBService == nil {
 		errMessage := "database service is not initialized"
 		slog.Error(errMessage)
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
+	var args GetSchemaInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	slog.Info("retrieving schema from the database")
 
-	// Execute the APOC schema query
-	records, err := deps.DBService.ExecuteReadQuery(ctx, schemaQuery, map[string]any{
-		"sampleSize": schemaSampleSize,
-	})
+	if args.SampleSize < 0 {
+		errMessage := "sampleSize must be a positive integer"
+		slog.Error(errMessage, "sampleSize", args.SampleSize)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if args.SampleSize > 0 {
+		schemaSampleSize = args.SampleSize
+	}
+	rich := deps.SchemaRichOutput
+	if args.Rich != nil {
+		rich = *args.Rich
+	}
+	structuredOutput, err := fetchCachedSchema(ctx, deps, schemaSampleSize, rich, args.Refresh, cache)
 	if err != nil {
-		slog.Error("failed to execute schema query", "error", err)
+		slog.Error("failed to retrieve schema", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	if len(records) == 0 {
+	if len(structuredOutput) == 0 {
 		slog.Warn("schema is empty, no data in the database")
-		return mcp.NewToolResultText("The get-schema tool executed successfully; however, since the Neo4j instance contains no data, no schema information was returned."), nil
+		return mcp.NewToolResultStructured(SchemaWithStats{Schema: []SchemaItem{}}, "The get-schema tool executed successfully; however, since the Neo4j instance contains no data, no schema information was returned."), nil
 	}
-	structuredOutput, err := processCypherSchema(records)
+	structuredOutput, err = filterSchema(structuredOutput, args.SchemaFilter)
 	if err != nil {
-		slog.Error("failed to process get-schema Cypher Query", "error", err)
+		slog.Error("failed to filter schema", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
-	jsonData, err := json.Marshal(structuredOutput)
+
+	if structuredOutput == nil {
+		structuredOutput = []SchemaItem{}
+	}
+	output := SchemaWithStats{Schema: structuredOutput}
+	// The text content keeps the bare list unless the statistics are requested
+	var textOutput any = structuredOutput
+	if args.IncludeStats {
+		stats, err := fetchGraphStats(ctx, deps.DBService)
+		if err != nil {
+			slog.Error("failed to retrieve graph statistics", "error", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		output.Stats = stats
+		textOutput = output
+	}
+
+	jsonData, err := json.Marshal(textOutput)
 	if err != nil {
 		slog.Error("failed to serialize structured schema", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 
 	}
-	return mcp.NewToolResultText(string(jsonData)), nil
+	return mcp.NewToolResultStructured(output, string(jsonData)), nil
+}
+
+// fetchSchema infers the schema with apoc.meta.schema when APOC is installed, with the core db.schema procedures otherwise.
+func fetchSchema(ctx context.Context, deps *tools.ToolDependencies, schemaSampleSize int32, rich bool) ([]SchemaItem, error) {
+	if !deps.APOCInstalled {
+		return inferSchemaWithCoreProcedures(ctx, deps.DBService, rich)
+	}
+	records, err := deps.DBService.ExecuteReadQuery(ctx, schemaQuery, map[string]any{
+		"sampleSize": schemaSampleSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return processCypherSchema(records, rich)
+}
+
+// fetchCachedSchema returns the schema from the cache when enabled, the cache keeps the rich schema
+// and the compact one is derived from it. refresh reads the schema again and updates the cache.
+func fetchCachedSchema(ctx context.Context, deps *tools.ToolDependencies, schemaSampleSize int32, rich, refresh bool, cache *SchemaCache) ([]SchemaItem, error) {
+	if cache == nil {
+		return fetchSchema(ctx, deps, schemaSampleSize, rich)
+	}
+	load := func(ctx context.Context) ([]SchemaItem, error) {
+		return fetchSchema(ctx, deps, schemaSampleSize, true)
+	}
+	key := newSchemaCacheKey(ctx, schemaSampleSize)
+	var schema []SchemaItem
+	var err error
+	if refresh {
+		schema, err = cache.reload(ctx, key, load)
+	} else {
+		schema, err = cache.get(ctx, key, load)
+	}
+	if err != nil || rich {
+		return schema, err
+	}
+	return compactSchema(schema), nil
+}
+
+// FetchSchema returns the schema as get-schema would, through the same cache, scoped by the filter.
+// It lets prompts embed the live schema. An empty database returns an empty schema.
+func FetchSchema(ctx context.Context, deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache, rich bool, filter SchemaFilter) ([]SchemaItem, error) {
+	schema, err := fetchCachedSchema(ctx, deps, schemaSampleSize, rich, false, cache)
+	if err != nil || len(schema) == 0 {
+		return schema, err
+	}
+	return filterSchema(schema, filter)
+}
+
+// SchemaWithStats is the structured output of the get-schema tool,
+// and its text output when the statistics are requested.
+type SchemaWithStats struct {
+	Schema []SchemaItem `json:"schema"`
+	Stats  *GraphStats  `json:"stats,omitempty"`
 }
 
 type SchemaItem struct {
@@ -73,16 +166,26 @@ type SchemaItem struct {
 	Value SchemaDetail `json:"value"`
 }
 
+// SchemaDetail describes a label or a relationship type.
+// Count and the property flags are only set in rich mode.
 type SchemaDetail struct {
 	Type          string                  `json:"type"`
+	Count         int64                   `json:"count,omitempty"`
 	Properties    map[string]string       `json:"properties,omitempty"`
+	Indexed       []string                `json:"indexed,omitempty"`   // Properties backed by an index
+	Unique        []string                `json:"unique,omitempty"`    // Properties with a uniqueness constraint
+	Mandatory     []string                `json:"mandatory,omitempty"` // Properties with an existence constraint
 	Relationships map[string]Relationship `json:"relationships,omitempty"`
 }
 
 type Relationship struct {
 	Direction  string            `json:"direction"`
 	Labels     []string          `json:"labels"` // List of target node labels
+	Count      int64             `json:"count,omitempty"`
 	Properties map[string]string `json:"properties,omitempty"`
+	Indexed    []string          `json:"indexed,omitempty"`
+	Unique     []string          `json:"unique,omitempty"`
+	Mandatory  []string          `json:"mandatory,omitempty"`
 }
 
 // processCypherSchema is a func that transforms a list of Neo4j.Record in a JSON tagged struct,
@@ -121,7 +224,9 @@ type Relationship struct {
 // To:
 // { ALWAYS: { direction: "out", labels: ["ACTED_IN"], properties: { releaseYear: "DATE" } } }
 // null values are stripped.
-func processCypherSchema(records []*neo4j.Record) ([]SchemaItem, error) {
+// In rich mode the counts are kept and the indexed, unique and existence flags are turned into sorted lists of property names:
+// { count: 16, properties: { releaseYear: "DATE" }, indexed: ["releaseYear"] }
+func processCypherSchema(records []*neo4j.Record, rich bool) ([]SchemaItem, error) {
 	simplifiedSchema := make([]SchemaItem, 0, len(records))
 
 	for _, record := range records {
@@ -200,23 +305,33 @@ func processCypherSchema(records []*neo4j.Record) ([]SchemaItem, error) {
 					if !ok {
 						return nil, fmt.Errorf("invalid relationship properties returned")
 					}
-					cleanRels[relName] = Relationship{
+					relationship := Relationship{
 						Direction:  direction,
 						Labels:     labels,
 						Properties: relProps,
 					}
+					if rich {
+						relationship.Count, _ = relDetails["count"].(int64)
+						relationship.Indexed, relationship.Unique, relationship.Mandatory = propertyFlags(relDetails["properties"])
+					}
+					cleanRels[relName] = relationship
 
 				}
 			}
 		}
 
+		detail := SchemaDetail{
+			Type:          itemType,
+			Properties:    cleanProps,
+			Relationships: cleanRels,
+		}
+		if rich {
+			detail.Count, _ = data["count"].(int64)
+			detail.Indexed, detail.Unique, detail.Mandatory = propertyFlags(data["properties"])
+		}
 		simplifiedSchema = append(simplifiedSchema, SchemaItem{
-			Key: keyStr,
-			Value: SchemaDetail{
-				Type:          itemType,
-				Properties:    cleanProps,
-				Relationships: cleanRels,
-			},
+			Key:   keyStr,
+			Value: detail,
 		})
 	}
 
@@ -241,3 +356,30 @@ func simplifyProperties(rawProps interface{}) (map[string]string, bool) {
 	}
 	return cleanProps, true
 }
+
+// propertyFlags returns the sorted names of the indexed, unique and mandatory (existence constraint) properties.
+func propertyFlags(rawProps interface{}) (indexed, unique, mandatory []string) {
+	props, ok := rawProps.(map[string]interface{})
+	if !ok {
+		return nil, nil, nil
+	}
+	for propName, rawPropDetails := range props {
+		propDetails, ok := rawPropDetails.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if flag, _ := propDetails["indexed"].(bool); flag {
+			indexed = append(indexed, propName)
+		}
+		if flag, _ := propDetails["unique"].(bool); flag {
+			unique = append(unique, propName)
+		}
+		if flag, _ := propDetails["existence"].(bool); flag {
+			mandatory = append(mandatory, propName)
+		}
+	}
+	slices.Sort(indexed)
+	slices.Sort(unique)
+	slices.Sort(mandatory)
+	return indexed, unique, mandatory
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -63,16 +65,167 @@ func TestGetSchemaHandler(t *testing.T) {
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
 			AnalyticsService: analyticsService,
+			APOCInstalled:    true,
 		}
 
-		handler := cypher.GetSchemaHandler(deps, 100)
+		handler := cypher.GetSchemaHandler(deps, 100, nil)
 		result, err := handler(context.Background(), mcp.CallToolRequest{})
 
 		if err != nil {
 			t.Errorf("Expected no error, got: %v", err)
 		}
 		if result == nil || result.IsError {
-			t.Error("Expected success result")
+			t.Fatal("Expected success result")
+		}
+		structured, ok := result.StructuredContent.(cypher.SchemaWithStats)
+		if !ok {
+			t.Fatalf("Expected the schema as structured content, got %T", result.StructuredContent)
+		}
+		if len(structured.Schema) != 2 || structured.Schema[0].Key != "Movie" || structured.Stats != nil {
+			t.Errorf("Expected the Movie and ACTED_IN items without statistics, got %+v", structured)
+		}
+	})
+
+	t.Run("schema retrieval with statistics", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Eq(map[string]any{"sampleSize": int32(100)})).
+			Return([]*neo4j.Record{
+				{
+					Keys:   []string{"key", "value"},
+					Values: []any{"Movie", map[string]any{"type": "node", "properties": map[string]any{}}},
+				},
+			}, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.labels()")), gomock.Nil()).
+			Return(tokensRecord([]any{"Movie"}, []any{}), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("UNION ALL")), gomock.Any()).
+			Return([]*neo4j.Record{countRecord("nodes", "", 3), countRecord("label", "Movie", 3)}, nil)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+			APOCInstalled:    true,
+		}
+
+		handler := cypher.GetSchemaHandler(deps, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"includeStats": true}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var output cypher.SchemaWithStats
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &output); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(output.Schema) != 1 || output.Stats == nil || output.Stats.Labels["Movie"] != 3 {
+			t.Errorf("Unexpected output %+v", output)
+		}
+	})
+
+	richSchemaRecords := func() []*neo4j.Record {
+		return []*neo4j.Record{
+			{
+				Keys: []string{"key", "value"},
+				Values: []any{
+					"Movie",
+					map[string]any{
+						"type":  "node",
+						"count": int64(38),
+						"properties": map[string]any{
+							"title":    map[string]any{"type": "STRING", "indexed": true, "unique": true, "existence": true},
+							"tagline":  map[string]any{"type": "STRING", "indexed": true, "unique": false, "existence": false},
+							"released": map[string]any{"type": "INTEGER", "indexed": false, "unique": false, "existence": false},
+						},
+						"relationships": map[string]any{
+							"ACTED_IN": map[string]any{
+								"count":     int64(172),
+								"direction": "in",
+								"labels":    []any{"Person"},
+								"properties": map[string]any{
+									"roles": map[string]any{"type": "LIST", "indexed": false, "unique": false, "existence": true},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	decodeSchema := func(t *testing.T, result *mcp.CallToolResult) []cypher.SchemaItem {
+		t.Helper()
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		var schema []cypher.SchemaItem
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &schema); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		return schema
+	}
+
+	t.Run("rich schema retrieval", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(richSchemaRecords(), nil)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"rich": true}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		movie := decodeSchema(t, result)[0].Value
+		if movie.Count != 38 {
+			t.Errorf("Expected count 38, got %d", movie.Count)
+		}
+		if !slices.Equal(movie.Indexed, []string{"tagline", "title"}) || !slices.Equal(movie.Unique, []string{"title"}) || !slices.Equal(movie.Mandatory, []string{"title"}) {
+			t.Errorf("Unexpected property flags %+v", movie)
+		}
+		actedIn := movie.Relationships["ACTED_IN"]
+		if actedIn.Count != 172 || !slices.Equal(actedIn.Mandatory, []string{"roles"}) {
+			t.Errorf("Unexpected relationship %+v", actedIn)
+		}
+	})
+
+	t.Run("rich mode from the configuration can be turned off per call", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(richSchemaRecords(), nil).
+			Times(2)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true, SchemaRichOutput: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if movie := decodeSchema(t, result)[0].Value; movie.Count != 38 || len(movie.Indexed) != 2 {
+			t.Errorf("Expected the configured rich output, got %+v", movie)
+		}
+
+		result, err = handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"rich": false}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		for _, field := range []string{`"count"`, `"indexed"`, `"unique"`, `"mandatory"`} {
+			if strings.Contains(textContent.Text, field) {
+				t.Errorf("Expected compact output without %s, got %s", field, textContent.Text)
+			}
 		}
 	})
 
@@ -85,9 +238,10 @@ func TestGetSchemaHandler(t *testing.T) {
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
 			AnalyticsService: analyticsService,
+			APOCInstalled:    true,
 		}
 
-		handler := cypher.GetSchemaHandler(deps, 100)
+		handler := cypher.GetSchemaHandler(deps, 100, nil)
 		result, err := handler(context.Background(), mcp.CallToolRequest{})
 
 		if err != nil {
@@ -104,7 +258,7 @@ func TestGetSchemaHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.GetSchemaHandler(deps, 100)
+		handler := cypher.GetSchemaHandler(deps, 100, nil)
 		result, err := handler(context.Background(), mcp.CallToolRequest{})
 
 		if err != nil {
@@ -124,9 +278,10 @@ func TestGetSchemaHandler(t *testing.T) {
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
 			AnalyticsService: analyticsService,
+			APOCInstalled:    true,
 		}
 
-		handler := cypher.GetSchemaHandler(deps, 100)
+		handler := cypher.GetSchemaHandler(deps, 100, nil)
 		result, err := handler(context.Background(), mcp.CallToolRequest{})
 
 		if err != nil {
@@ -509,9 +664,10 @@ func TestGetSchemaProcessing(t *testing.T) {
 			deps := &tools.ToolDependencies{
 				DBService:        mockDB,
 				AnalyticsService: analyticsService,
+				APOCInstalled:    true,
 			}
 
-			handler := cypher.GetSchemaHandler(deps, 100)
+			handler := cypher.GetSchemaHandler(deps, 100, nil)
 			result, err := handler(context.Background(), mcp.CallToolRequest{})
 
 			if err != nil {
@@ -547,3 +703,188 @@ func TestGetSchemaProcessing(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSchemaHandlerFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	schemaRecord := func(key string, value map[string]any) *neo4j.Record {
+		return &neo4j.Record{Keys: []string{"key", "value"}, Values: []any{key, value}}
+	}
+	relationshipTo := func(direction string, labels ...any) map[string]any {
+		return map[string]any{"direction": direction, "labels": labels, "properties": map[string]any{}}
+	}
+	properties := map[string]any{"name": map[string]any{"type": "STRING"}}
+	records := []*neo4j.Record{
+		schemaRecord("Person", map[string]any{"type": "node", "properties": properties, "relationships": map[string]any{
+			"ACTED_IN": relationshipTo("out", "Movie"),
+			"LIVES_IN": relationshipTo("out", "City"),
+		}}),
+		schemaRecord("Movie", map[string]any{"type": "node", "properties": properties, "relationships": map[string]any{
+			"ACTED_IN": relationshipTo("in", "Person"),
+			"IN_GENRE": relationshipTo("out", "Genre"),
+		}}),
+		schemaRecord("City", map[string]any{"type": "node", "properties": properties, "relationships": map[string]any{
+			"LIVES_IN": relationshipTo("in", "Person"),
+		}}),
+		schemaRecord("Genre", map[string]any{"type": "node", "properties": properties, "relationships": map[string]any{
+			"IN_GENRE": relationshipTo("in", "Movie"),
+		}}),
+		schemaRecord("ACTED_IN", map[string]any{"type": "relationship", "properties": map[string]any{"roles": map[string]any{"type": "LIST"}}}),
+		schemaRecord("LIVES_IN", map[string]any{"type": "relationship", "properties": map[string]any{}}),
+		schemaRecord("IN_GENRE", map[string]any{"type": "relationship", "properties": map[string]any{}}),
+	}
+
+	// summary returns the kept keys, with the relationship types of the node items
+	summary := func(t *testing.T, result *mcp.CallToolResult) []string {
+		t.Helper()
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		var schema []cypher.SchemaItem
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &schema); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		keys := make([]string, 0, len(schema))
+		for _, item := range schema {
+			types := make([]string, 0, len(item.Value.Relationships))
+			for relType := range item.Value.Relationships {
+				types = append(types, relType)
+			}
+			slices.Sort(types)
+			if len(types) > 0 {
+				keys = append(keys, item.Key+"("+strings.Join(types, ",")+")")
+			} else {
+				keys = append(keys, item.Key)
+			}
+		}
+		return keys
+	}
+
+	testCases := []struct {
+		name     string
+		args     map[string]any
+		expected []string
+	}{
+		{
+			name:     "labels",
+			args:     map[string]any{"labels": []any{"Person"}},
+			expected: []string{"Person(ACTED_IN,LIVES_IN)", "ACTED_IN", "LIVES_IN"},
+		},
+		{
+			name:     "relationship types",
+			args:     map[string]any{"relationshipTypes": []any{"LIVES_IN"}},
+			expected: []string{"Person(LIVES_IN)", "City(LIVES_IN)", "LIVES_IN"},
+		},
+		{
+			name:     "labels and relationship types",
+			args:     map[string]any{"labels": []any{"Person", "Genre"}, "relationshipTypes": []any{"ACTED_IN"}},
+			expected: []string{"Person(ACTED_IN)", "Genre", "ACTED_IN"},
+		},
+		{
+			name:     "neighbors of a label",
+			args:     map[string]any{"neighborsOf": "Movie"},
+			expected: []string{"Person(ACTED_IN)", "Movie(ACTED_IN,IN_GENRE)", "Genre(IN_GENRE)", "ACTED_IN", "IN_GENRE"},
+		},
+		{
+			name:     "neighbors of a label through some relationship types",
+			args:     map[string]any{"neighborsOf": "Person", "relationshipTypes": []any{"LIVES_IN"}},
+			expected: []string{"Person(LIVES_IN)", "City(LIVES_IN)", "LIVES_IN"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(records, nil)
+
+			handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, nil)
+			result, err := handler(context.Background(), mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Arguments: tc.args},
+			})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if keys := summary(t, result); !slices.Equal(keys, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, keys)
+			}
+		})
+	}
+
+	t.Run("without properties", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(records, nil)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"includeProperties": false}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if keys := summary(t, result); len(keys) != len(records) {
+			t.Errorf("Expected the whole schema, got %v", keys)
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		if strings.Contains(textContent.Text, `"properties"`) {
+			t.Errorf("Expected no properties, got %s", textContent.Text)
+		}
+	})
+
+	t.Run("unknown neighbors label", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(records, nil)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"neighborsOf": "Studio"}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Fatal("Expected error result")
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, `label "Studio" not found`) {
+			t.Errorf("Unexpected error %q", text)
+		}
+	})
+
+	t.Run("sample size override", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Eq(map[string]any{"sampleSize": int32(1000)})).
+			Return(records, nil)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"sampleSize": 1000}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("negative sample size", func(t *testing.T) {
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl), APOCInstalled: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: map[string]any{"sampleSize": -1}},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Fatal("Expected error result")
+		}
+	})
+}
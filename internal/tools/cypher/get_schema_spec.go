@@ -7,11 +7,32 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
+type GetSchemaInput struct {
+	Database     string `json:"database,omitempty" jsonschema:"The database to retrieve the schema from, defaults to the configured database. Use list-databases to discover the available databases"`
+	IncludeStats bool   `json:"includeStats,omitempty" jsonschema:"If true, the node and relationship counts of get-graph-stats are returned together with the schema"`
+	Rich         *bool  `json:"rich,omitempty" jsonschema:"If true, the schema also lists the indexed, unique and mandatory properties and the node and relationship counts. Defaults to the server configuration"`
+	SchemaFilter
+	SampleSize int32 `json:"sampleSize,omitempty" jsonschema:"Number of nodes sampled per label by apoc.meta.schema, defaults to the server configuration"`
+	Refresh    bool  `json:"refresh,omitempty" jsonschema:"If true, the schema is read from the database again instead of the server cache"`
+}
+
+// SchemaFilter scopes the get-schema output to a slice of the schema.
+type SchemaFilter struct {
+	Labels            []string `json:"labels,omitempty" jsonschema:"Only return these node labels, with their relationships and the relationship types they use"`
+	RelationshipTypes []string `json:"relationshipTypes,omitempty" jsonschema:"Only return these relationship types, and the node labels they connect unless labels is set"`
+	NeighborsOf       string   `json:"neighborsOf,omitempty" jsonschema:"Only return this node label, the labels directly connected to it and the relationship types between them"`
+	IncludeProperties *bool    `json:"includeProperties,omitempty" jsonschema:"If false, the properties are left out to keep the output small. Defaults to true"`
+}
+
 func GetSchemaSpec() mcp.Tool {
 	return mcp.NewTool("get-schema",
 		mcp.WithDescription(`
 		Retrieve the schema information from the Neo4j database, including node labels, relationship types, and property keys.
-		If the database contains no data, no schema information is returned.`),
+		If the database contains no data, no schema information is returned.
+		Set includeStats to true to also get the node counts per label and the relationship counts per type and pattern.
+		The schema may be cached by the server, set refresh to true after changing the data model outside of write-cypher.`),
+		mcp.WithInputSchema[GetSchemaInput](),
+		mcp.WithOutputSchema[SchemaWithStats](),
 		mcp.WithTitleAnnotation("Get Neo4j Schema"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithIdempotentHintAnnotation(true),
@@ -0,0 +1,101 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// targetsDatabase returns a gomock condition matching a context that targets the given database.
+func targetsDatabase(name string) func(any) bool {
+	return func(ctx any) bool {
+		c, ok := ctx.(context.Context)
+		if !ok {
+			return false
+		}
+		target, ok := database.GetTargetDatabase(c)
+		return ok && target == name
+	}
+}
+
+// fakePlan is a minimal neo4j.Plan implementation used to build plan trees in tests.
+type fakePlan struct {
+	operator    string
+	arguments   map[string]any
+	identifiers []string
+	children    []neo4j.Plan
+}
+
+func (p *fakePlan) Operator() string          { return p.operator }
+func (p *fakePlan) Arguments() map[string]any { return p.arguments }
+func (p *fakePlan) Identifiers() []string     { return p.identifiers }
+func (p *fakePlan) Children() []neo4j.Plan    { return p.children }
+
+// fakeProfiledPlan is a minimal neo4j.ProfiledPlan implementation used to build profiled plan trees in tests.
+type fakeProfiledPlan struct {
+	operator        string
+	arguments       map[string]any
+	identifiers     []string
+	dbHits          int64
+	records         int64
+	pageCacheHits   int64
+	pageCacheMisses int64
+	children        []neo4j.ProfiledPlan
+}
+
+func (p *fakeProfiledPlan) Operator() string               { return p.operator }
+func (p *fakeProfiledPlan) Arguments() map[string]any      { return p.arguments }
+func (p *fakeProfiledPlan) Identifiers() []string          { return p.identifiers }
+func (p *fakeProfiledPlan) DbHits() int64                  { return p.dbHits }
+func (p *fakeProfiledPlan) Records() int64                 { return p.records }
+func (p *fakeProfiledPlan) Children() []neo4j.ProfiledPlan { return p.children }
+func (p *fakeProfiledPlan) PageCacheMisses() int64         { return p.pageCacheMisses }
+func (p *fakeProfiledPlan) PageCacheHits() int64           { return p.pageCacheHits }
+func (p *fakeProfiledPlan) PageCacheHitRatio() float64     { return 0 }
+func (p *fakeProfiledPlan) Time() int64                    { return 0 }
+
+// fakeSummary overrides the parts of neo4j.ResultSummary used by the handlers,
+// calling any other method panics.
+type fakeSummary struct {
+	neo4j.ResultSummary
+	queryType neo4j.QueryType
+	plan      neo4j.Plan
+	profile   neo4j.ProfiledPlan
+	counters  neo4j.Counters
+}
+
+func (s *fakeSummary) QueryType() neo4j.QueryType          { return s.queryType }
+func (s *fakeSummary) Plan() neo4j.Plan                    { return s.plan }
+func (s *fakeSummary) Profile() neo4j.ProfiledPlan         { return s.profile }
+func (s *fakeSummary) ResultAvailableAfter() time.Duration { return 2 * time.Millisecond }
+func (s *fakeSummary) ResultConsumedAfter() time.Duration  { return 3 * time.Millisecond }
+func (s *fakeSummary) Counters() neo4j.Counters            { return s.counters }
+
+// fakeCounters reports the updates of a write query, calling any other method panics.
+type fakeCounters struct {
+	neo4j.Counters
+	nodesCreated  int
+	nodesDeleted  int
+	relsCreated   int
+	propertiesSet int
+	labelsAdded   int
+	indexesAdded  int
+}
+
+func (c *fakeCounters) NodesCreated() int         { return c.nodesCreated }
+func (c *fakeCounters) NodesDeleted() int         { return c.nodesDeleted }
+func (c *fakeCounters) RelationshipsCreated() int { return c.relsCreated }
+func (c *fakeCounters) RelationshipsDeleted() int { return 0 }
+func (c *fakeCounters) PropertiesSet() int        { return c.propertiesSet }
+func (c *fakeCounters) LabelsAdded() int          { return c.labelsAdded }
+func (c *fakeCounters) LabelsRemoved() int        { return 0 }
+func (c *fakeCounters) IndexesAdded() int         { return c.indexesAdded }
+func (c *fakeCounters) IndexesRemoved() int       { return 0 }
+func (c *fakeCounters) ConstraintsAdded() int     { return 0 }
+func (c *fakeCounters) ConstraintsRemoved() int   { return 0 }
+func (c *fakeCounters) SystemUpdates() int        { return 0 }
@@ -0,0 +1,111 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/config"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	// systemDatabase is the database administration commands such as SHOW DATABASES run against
+	systemDatabase = "system"
+	// showDatabasesQuery lists the user databases, the system database cannot be queried with Cypher
+	showDatabasesQuery = `
+        SHOW DATABASES
+        YIELD name, type, aliases, access, currentStatus, default, home
+        WHERE type <> 'system'
+        RETURN name, type, aliases, access, currentStatus, default, home
+        ORDER BY name
+    `
+)
+
+// DatabaseInfo is a JSON tagged representation of a row returned by SHOW DATABASES.
+type DatabaseInfo struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type,omitempty"`
+	Aliases       []string `json:"aliases,omitempty"`
+	Access        string   `json:"access,omitempty"`
+	CurrentStatus string   `json:"currentStatus,omitempty"`
+	Default       bool     `json:"default"`
+	Home          bool     `json:"home"`
+}
+
+// DatabaseList is the structured output of the list-databases tool, the text content holds the bare list.
+type DatabaseList struct {
+	Databases []DatabaseInfo `json:"databases"`
+}
+
+// ListDatabasesHandler returns a handler function for the list-databases tool
+func ListDatabasesHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListDatabases(ctx, deps)
+	}
+}
+
+func handleListDatabases(ctx context.Context, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	slog.Info("retrieving the list of databases")
+
+	records, err := deps.DBService.ExecuteReadQuery(database.WithTargetDatabase(ctx, systemDatabase), showDatabasesQuery, nil)
+	if err != nil {
+		slog.Error("failed to execute show databases query", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// In a cluster SHOW DATABASES returns a row for each server hosting the database
+	seen := make(map[string]bool, len(records))
+	databases := make([]DatabaseInfo, 0, len(records))
+	for _, record := range records {
+		info, err := recordToDatabaseInfo(record)
+		if err != nil {
+			slog.Error("failed to process show databases result", "error", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if seen[info.Name] || !config.IsDatabaseAllowed(deps.AllowedDatabases, info.Name) {
+			continue
+		}
+		seen[info.Name] = true
+		databases = append(databases, info)
+	}
+
+	jsonData, err := json.Marshal(databases)
+	if err != nil {
+		slog.Error("failed to serialize databases", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(DatabaseList{Databases: databases}, string(jsonData)), nil
+}
+
+func recordToDatabaseInfo(record *neo4j.Record) (DatabaseInfo, error) {
+	var info DatabaseInfo
+	var ok bool
+	if info.Name, ok = recordString(record, "name"); !ok {
+		return DatabaseInfo{}, fmt.Errorf("invalid database name returned")
+	}
+	info.Type, _ = recordString(record, "type")
+	info.Aliases = recordStrings(record, "aliases")
+	info.Access, _ = recordString(record, "access")
+	info.CurrentStatus, _ = recordString(record, "currentStatus")
+	if isDefault, found := record.Get("default"); found {
+		info.Default, _ = isDefault.(bool)
+	}
+	if isHome, found := record.Get("home"); found {
+		info.Home, _ = isHome.(bool)
+	}
+	return info, nil
+}
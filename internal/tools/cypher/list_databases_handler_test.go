@@ -0,0 +1,119 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDatabasesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := []string{"name", "type", "aliases", "access", "currentStatus", "default", "home"}
+	records := []*neo4j.Record{
+		{Keys: keys, Values: []any{"movies", "standard", []any{"films"}, "read-write", "online", false, false}},
+		{Keys: keys, Values: []any{"movies", "standard", []any{"films"}, "read-write", "online", false, false}},
+		{Keys: keys, Values: []any{"neo4j", "standard", []any{}, "read-write", "online", true, true}},
+		{Keys: keys, Values: []any{"secrets", "standard", []any{}, "read-only", "online", false, false}},
+	}
+
+	t.Run("lists allowed databases once", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Cond(targetsDatabase("system")), gomock.Any(), gomock.Nil()).
+			Return(records, nil)
+
+		handler := cypher.ListDatabasesHandler(&tools.ToolDependencies{DBService: mockDB, AllowedDatabases: []string{"neo4j", "movies"}})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var output []cypher.DatabaseInfo
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &output); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(output) != 2 {
+			t.Fatalf("Expected 2 databases, got %d: %+v", len(output), output)
+		}
+		if output[0].Name != "movies" || len(output[0].Aliases) != 1 || output[0].Aliases[0] != "films" {
+			t.Errorf("Unexpected database %+v", output[0])
+		}
+		if output[1].Name != "neo4j" || !output[1].Default || !output[1].Home {
+			t.Errorf("Unexpected database %+v", output[1])
+		}
+
+		structured, ok := result.StructuredContent.(cypher.DatabaseList)
+		if !ok {
+			t.Fatalf("Expected a database list, got %T", result.StructuredContent)
+		}
+		if len(structured.Databases) != 2 || structured.Databases[0].Name != "movies" {
+			t.Errorf("Expected the structured content to hold the same databases, got %+v", structured.Databases)
+		}
+	})
+
+	t.Run("wildcard allows every database", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(records, nil)
+
+		handler := cypher.ListDatabasesHandler(&tools.ToolDependencies{DBService: mockDB, AllowedDatabases: []string{"*"}})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		var output []cypher.DatabaseInfo
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &output); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if len(output) != 3 {
+			t.Errorf("Expected 3 databases, got %d", len(output))
+		}
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(nil, errors.New("permission denied"))
+
+		handler := cypher.ListDatabasesHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.ListDatabasesHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func ListDatabasesSpec() mcp.Tool {
+	return mcp.NewTool("list-databases",
+		mcp.WithDescription(`
+		List the databases of the Neo4j DBMS that can be targeted by this server.
+		For each database it returns the name, the type, the aliases, the access mode, the current status and whether it is the default or the home database.
+		Pass the name of a database as the "database" argument of read-cypher, write-cypher or get-schema to run against it.`),
+		mcp.WithOutputSchema[DatabaseList](),
+		mcp.WithTitleAnnotation("List Neo4j Databases"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// QueryProfile is the output of the profile-cypher tool.
+type QueryProfile struct {
+	QueryType string                `json:"queryType"`
+	ElapsedMs int64                 `json:"elapsedMs"`
+	Totals    ProfileTotals         `json:"totals"`
+	Profile   *ProfiledPlanOperator `json:"profile"`
+	Results   json.RawMessage       `json:"results,omitempty"`
+}
+
+// ProfileTotals aggregates the statistics of every operator of a profiled plan.
+type ProfileTotals struct {
+	Rows            int64 `json:"rows"`
+	DbHits          int64 `json:"dbHits"`
+	PageCacheHits   int64 `json:"pageCacheHits"`
+	PageCacheMisses int64 `json:"pageCacheMisses"`
+}
+
+func ProfileCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleProfileCypher(ctx, request, deps)
+	}
+}
+
+func handleProfileCypher(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "Database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args ProfileCypherInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Validate that query is not empty
+	if args.Query == "" {
+		errMessage := "Query parameter is required and cannot be empty"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	slog.Info("profiling cypher query", "query", args.Query)
+
+	// PROFILE executes the query, so the same read-only check of read-cypher applies
+	queryType, err := deps.DBService.GetQueryType(ctx, args.Query, args.Params)
+	if err != nil {
+		slog.Error("error classifying cypher query", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if queryType != neo4j.QueryTypeReadOnly {
+		errMessage := "profile-cypher can only profile read-only Cypher statements."
+		slog.Error("rejected non-read query", "type", queryType, "query", args.Query)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	records, summary, err := deps.DBService.ProfileQuery(ctx, args.Query, args.Params)
+	if err != nil {
+		slog.Error("error profiling cypher query", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if summary.Profile() == nil {
+		errMessage := "no profile returned for the profiled query"
+		slog.Error(errMessage, "query", args.Query)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	profile := profiledPlanToOperator(summary.Profile())
+	output := QueryProfile{
+		QueryType: queryTypeName(summary.QueryType()),
+		ElapsedMs: (summary.ResultAvailableAfter() + summary.ResultConsumedAfter()).Milliseconds(),
+		Totals:    sumProfileTotals(profile),
+		Profile:   profile,
+	}
+
+	if !args.SuppressResults {
+		response, err := deps.DBService.Neo4jRecordsToJSON(records)
+		if err != nil {
+			slog.Error("error formatting query results", "error", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		output.Results = json.RawMessage(response)
+	}
+
+	jsonData, err := json.Marshal(output)
+	if err != nil {
+		slog.Error("failed to serialize query profile", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultStructured(output, string(jsonData)), nil
+}
+
+// sumProfileTotals walks the profiled plan and sums up the statistics of every operator.
+// The rows are taken from the root operator, as they are the rows returned by the query.
+func sumProfileTotals(root *ProfiledPlanOperator) ProfileTotals {
+	totals := ProfileTotals{Rows: root.Rows}
+	var walk func(operator *ProfiledPlanOperator)
+	walk = func(operator *ProfiledPlanOperator) {
+		totals.DbHits += operator.DbHits
+		totals.PageCacheHits += operator.PageCacheHits
+		totals.PageCacheMisses += operator.PageCacheMisses
+		for _, child := range operator.Children {
+			walk(child)
+		}
+	}
+	walk(root)
+	return totals
+}
@@ -0,0 +1,186 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProfileCypherHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	profiledSummary := &fakeSummary{
+		queryType: neo4j.QueryTypeReadOnly,
+		profile: &fakeProfiledPlan{
+			operator:      "ProduceResults@neo4j",
+			arguments:     map[string]any{"Rows": int64(2), "DbHits": int64(0), "EstimatedRows": 2.0},
+			identifiers:   []string{"n"},
+			records:       2,
+			pageCacheHits: 1,
+			children: []neo4j.ProfiledPlan{
+				&fakeProfiledPlan{
+					operator:        "NodeByLabelScan@neo4j",
+					arguments:       map[string]any{"Details": "n:Person"},
+					identifiers:     []string{"n"},
+					records:         2,
+					dbHits:          3,
+					pageCacheHits:   4,
+					pageCacheMisses: 1,
+				},
+			},
+		},
+	}
+
+	t.Run("returns the profile and the results", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			GetQueryType(gomock.Any(), "MATCH (n:Person) RETURN n", gomock.Nil()).
+			Return(neo4j.QueryTypeReadOnly, nil)
+		mockDB.EXPECT().
+			ProfileQuery(gomock.Any(), "MATCH (n:Person) RETURN n", gomock.Nil()).
+			Return([]*neo4j.Record{}, profiledSummary, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[{"n": {"name": "Alice"}}, {"n": {"name": "Bob"}}]`, nil)
+
+		handler := cypher.ProfileCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "MATCH (n:Person) RETURN n"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var profile cypher.QueryProfile
+		textContent, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			t.Fatalf("Expected text content, got %T", result.Content[0])
+		}
+		if err := json.Unmarshal([]byte(textContent.Text), &profile); err != nil {
+			t.Fatalf("Failed to unmarshal profile: %v", err)
+		}
+		if profile.ElapsedMs != 5 {
+			t.Errorf("Expected 5ms elapsed, got %d", profile.ElapsedMs)
+		}
+		expectedTotals := cypher.ProfileTotals{Rows: 2, DbHits: 3, PageCacheHits: 5, PageCacheMisses: 1}
+		if profile.Totals != expectedTotals {
+			t.Errorf("Expected totals %+v, got %+v", expectedTotals, profile.Totals)
+		}
+		if profile.Profile.Arguments != nil {
+			t.Errorf("Expected duplicated statistics to be stripped from the arguments, got %v", profile.Profile.Arguments)
+		}
+		if len(profile.Results) == 0 {
+			t.Error("Expected results to be returned")
+		}
+	})
+
+	t.Run("suppresses the results", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			GetQueryType(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(neo4j.QueryTypeReadOnly, nil)
+		mockDB.EXPECT().
+			ProfileQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*neo4j.Record{}, profiledSummary, nil)
+		// Neo4jRecordsToJSON must not be called
+
+		handler := cypher.ProfileCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "MATCH (n:Person) RETURN n", "suppressResults": true},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		var output map[string]any
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &output); err != nil {
+			t.Fatalf("Failed to unmarshal profile: %v", err)
+		}
+		if _, ok := output["results"]; ok {
+			t.Error("Expected results to be omitted")
+		}
+	})
+
+	t.Run("rejects write queries", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			GetQueryType(gomock.Any(), "CREATE (n:Person)", gomock.Nil()).
+			Return(neo4j.QueryTypeWriteOnly, nil)
+
+		handler := cypher.ProfileCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "CREATE (n:Person)"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for write query")
+		}
+	})
+
+	t.Run("profile failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			GetQueryType(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(neo4j.QueryTypeReadOnly, nil)
+		mockDB.EXPECT().
+			ProfileQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil, errors.New("connection failed"))
+
+		handler := cypher.ProfileCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{"query": "MATCH (n) RETURN n"},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for profile failure")
+		}
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+
+		handler := cypher.ProfileCypherHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{},
+			},
+		})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for empty query")
+		}
+	})
+}
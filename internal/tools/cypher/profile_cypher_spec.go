@@ -0,0 +1,30 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type ProfileCypherInput struct {
+	Query           string `json:"query" jsonschema:"The read-only Cypher query to profile"`
+	Params          Params `json:"params,omitempty" jsonschema:"Parameters to pass to the Cypher query"`
+	SuppressResults bool   `json:"suppressResults,omitempty" jsonschema:"If true, the result rows are not returned, only the profile"`
+}
+
+func ProfileCypherSpec() mcp.Tool {
+	return mcp.NewTool("profile-cypher",
+		mcp.WithDescription("profile-cypher runs a read-only Cypher statement with PROFILE and returns the executed plan, "+
+			"where each operator reports the actual rows, db hits, page cache hits and misses, together with the overall elapsed time. "+
+			"Use it to find the expensive parts of a query. Set suppressResults to true to omit the result rows. "+
+			"Write statements are rejected."),
+		mcp.WithInputSchema[ProfileCypherInput](),
+		withPlanOutputSchema[QueryProfile](),
+		mcp.WithTitleAnnotation("Profile Cypher"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// Plan arguments handled explicitly or dropped to keep the serialized plan compact.
+const (
+	planArgEstimatedRows        = "EstimatedRows"
+	planArgStringRepresentation = "string-representation"
+)
+
+// profiledPlanArgs are repeated by Neo4j in the arguments of profiled operators,
+// they are dropped as the driver already exposes them as dedicated statistics.
+var profiledPlanArgs = map[string]bool{
+	"Rows":              true,
+	"DbHits":            true,
+	"PageCacheHits":     true,
+	"PageCacheMisses":   true,
+	"PageCacheHitRatio": true,
+	"Time":              true,
+}
+
+// PlanOperator is a JSON tagged representation of a single operator of a Neo4j query plan.
+type PlanOperator struct {
+	Operator      string          `json:"operator"`
+	EstimatedRows *float64        `json:"estimatedRows,omitempty"`
+	Identifiers   []string        `json:"identifiers,omitempty"`
+	Arguments     map[string]any  `json:"arguments,omitempty"`
+	Children      []*PlanOperator `json:"children,omitempty"`
+}
+
+// QueryPlan is the output of the explain-cypher tool.
+type QueryPlan struct {
+	QueryType string        `json:"queryType"`
+	Plan      *PlanOperator `json:"plan"`
+}
+
+// ProfiledPlanOperator is a JSON tagged representation of a single operator of a profiled Neo4j query plan.
+type ProfiledPlanOperator struct {
+	Operator          string                  `json:"operator"`
+	EstimatedRows     *float64                `json:"estimatedRows,omitempty"`
+	Rows              int64                   `json:"rows"`
+	DbHits            int64                   `json:"dbHits"`
+	PageCacheHits     int64                   `json:"pageCacheHits"`
+	PageCacheMisses   int64                   `json:"pageCacheMisses"`
+	PageCacheHitRatio float64                 `json:"pageCacheHitRatio"`
+	Time              int64                   `json:"time,omitempty"` // as reported by Neo4j, only available with some runtimes
+	Identifiers       []string                `json:"identifiers,omitempty"`
+	Arguments         map[string]any          `json:"arguments,omitempty"`
+	Children          []*ProfiledPlanOperator `json:"children,omitempty"`
+}
+
+// withPlanOutputSchema declares the output schema of the tools returning a plan tree.
+// The schema generator rejects the recursive operators, so their children are declared as a list of objects,
+// and the raw query results of profile-cypher as a list of rows.
+func withPlanOutputSchema[T any]() mcp.ToolOption {
+	children := &jsonschema.Schema{
+		Type:        "array",
+		Description: "The child operators, with the same fields as their parent",
+		Items:       &jsonschema.Schema{Type: "object"},
+	}
+	schema, err := jsonschema.For[T](&jsonschema.ForOptions{
+		IgnoreInvalidTypes: true,
+		TypeSchemas: map[reflect.Type]*jsonschema.Schema{
+			reflect.TypeFor[[]*PlanOperator]():         children,
+			reflect.TypeFor[[]*ProfiledPlanOperator](): children,
+			reflect.TypeFor[json.RawMessage](): {
+				Type:        "array",
+				Description: "The result rows, keyed by column",
+				Items:       &jsonschema.Schema{Type: "object"},
+			},
+		},
+	})
+	if err != nil {
+		// Like mcp.WithOutputSchema, the tool is declared without an output schema
+		return func(*mcp.Tool) {}
+	}
+	schema.Type = "object"
+	rawSchema, err := json.Marshal(schema)
+	if err != nil {
+		return func(*mcp.Tool) {}
+	}
+	return mcp.WithRawOutputSchema(rawSchema)
+}
+
+// planToOperator converts the plan returned by the driver into a PlanOperator tree.
+// The estimated rows are promoted to a dedicated field, while the textual representation of
+// the whole plan (repeated by Neo4j in the root operator) is dropped as it duplicates the tree.
+func planToOperator(plan neo4j.Plan) *PlanOperator {
+	if plan == nil {
+		return nil
+	}
+	operator := &PlanOperator{
+		Operator:    plan.Operator(),
+		Identifiers: plan.Identifiers(),
+	}
+	operator.EstimatedRows, operator.Arguments = splitPlanArguments(plan.Arguments())
+
+	for _, child := range plan.Children() {
+		operator.Children = append(operator.Children, planToOperator(child))
+	}
+	return operator
+}
+
+// profiledPlanToOperator converts the profiled plan returned by the driver into a ProfiledPlanOperator tree.
+func profiledPlanToOperator(plan neo4j.ProfiledPlan) *ProfiledPlanOperator {
+	if plan == nil {
+		return nil
+	}
+	operator := &ProfiledPlanOperator{
+		Operator:          plan.Operator(),
+		Rows:              plan.Records(),
+		DbHits:            plan.DbHits(),
+		PageCacheHits:     plan.PageCacheHits(),
+		PageCacheMisses:   plan.PageCacheMisses(),
+		PageCacheHitRatio: plan.PageCacheHitRatio(),
+		Time:              plan.Time(),
+		Identifiers:       plan.Identifiers(),
+	}
+	var args map[string]any
+	operator.EstimatedRows, args = splitPlanArguments(plan.Arguments())
+	for key := range profiledPlanArgs {
+		delete(args, key)
+	}
+	if len(args) > 0 {
+		operator.Arguments = args
+	}
+
+	for _, child := range plan.Children() {
+		operator.Children = append(operator.Children, profiledPlanToOperator(child))
+	}
+	return operator
+}
+
+// splitPlanArguments extracts the estimated rows from the plan arguments and returns the remaining arguments.
+func splitPlanArguments(rawArgs map[string]any) (*float64, map[string]any) {
+	var estimatedRows *float64
+	args := make(map[string]any, len(rawArgs))
+	for key, value := range rawArgs {
+		switch key {
+		case planArgEstimatedRows:
+			if rows, ok := toFloat64(value); ok {
+				estimatedRows = &rows
+			}
+		case planArgStringRepresentation:
+			// skip, the tree already carries the same information
+		default:
+			args[key] = value
+		}
+	}
+	if len(args) == 0 {
+		return estimatedRows, nil
+	}
+	return estimatedRows, args
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// queryTypeName returns a readable name for the query type reported by Neo4j.
+func queryTypeName(queryType neo4j.QueryType) string {
+	switch queryType {
+	case neo4j.QueryTypeReadOnly:
+		return "READ_ONLY"
+	case neo4j.QueryTypeReadWrite:
+		return "READ_WRITE"
+	case neo4j.QueryTypeWriteOnly:
+		return "WRITE_ONLY"
+	case neo4j.QueryTypeSchemaWrite:
+		return "SCHEMA_WRITE"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -34,6 +34,12 @@ func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, deps *to
 	Query := args.Query
 	Params := args.Params
 
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	slog.Info("executing read cypher query", "query", Query)
 
 	// Validate that query is not empty
@@ -51,11 +57,14 @@ func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, deps *to
 	}
 
 	if queryType != neo4j.QueryTypeReadOnly { // only queryType == "r" are allowed in read-cypher
-		errMessage := "read-cypher can only run read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...), schema/admin commands, or PROFILE queries, use write-cypher instead."
+		errMessage := "read-cypher can only run read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...) or schema/admin commands, use write-cypher instead. To profile a read-only query, use profile-cypher."
 		slog.Error("rejected non-read query", "type", queryType, "query", Query)
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
+	ctx, stopProgress := tools.NewProgressReporter(ctx, request).TrackQuery(ctx, deps, Query)
+	defer stopProgress()
+
 	// Execute the Cypher query using the database service (now confirmed read-only)
 	records, err := deps.DBService.ExecuteReadQuery(ctx, Query, Params)
 	if err != nil {
@@ -63,12 +72,15 @@ func handleReadCypher(ctx context.Context, request mcp.CallToolRequest, deps *to
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	result, records := deps.NewQueryResult(records)
+	result.Summary = &tools.QuerySummary{QueryType: queryTypeName(queryType)}
+
 	// Format records to JSON
-	response, err := deps.DBService.Neo4jRecordsToJSON(records)
+	response, err := deps.FormatRecords(ctx, records)
 	if err != nil {
 		slog.Error("error formatting query results", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return mcp.NewToolResultStructured(result, response), nil
 }
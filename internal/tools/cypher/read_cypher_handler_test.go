@@ -6,6 +6,7 @@ package cypher_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -350,4 +351,132 @@ func TestReadCypherHandler(t *testing.T) {
 			t.Error("Expected error result for explain failure")
 		}
 	})
+
+	t.Run("query runs against the requested database", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			GetQueryType(gomock.Cond(targetsDatabase("movies")), "MATCH (n) RETURN n", gomock.Nil()).
+			Return(neo4j.QueryTypeReadOnly, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Cond(targetsDatabase("movies")), "MATCH (n) RETURN n", gomock.Nil()).
+			Return([]*neo4j.Record{}, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[]`, nil)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+			AllowedDatabases: []string{"neo4j", "movies"},
+		}
+
+		handler := cypher.ReadCypherHandler(deps)
+		request := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"query":    "MATCH (n) RETURN n",
+					"database": "movies",
+				},
+			},
+		}
+
+		result, err := handler(context.Background(), request)
+
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Errorf("Expected success result, got: %v", result)
+		}
+	})
+
+	t.Run("database not in the allowlist", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+			AllowedDatabases: []string{"neo4j"},
+		}
+
+		handler := cypher.ReadCypherHandler(deps)
+		request := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"query":    "MATCH (n) RETURN n",
+					"database": "secrets",
+				},
+			},
+		}
+
+		result, err := handler(context.Background(), request)
+
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for a database outside the allowlist")
+		}
+	})
+
+	t.Run("structured content keeps the first rows", func(t *testing.T) {
+		records := []*neo4j.Record{
+			{Keys: []string{"name"}, Values: []any{"Alice"}},
+			{Keys: []string{"name"}, Values: []any{"Bob"}},
+			{Keys: []string{"name"}, Values: []any{"Carol"}},
+		}
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			GetQueryType(gomock.Any(), "MATCH (n:Person) RETURN n.name AS name", gomock.Nil()).
+			Return(neo4j.QueryTypeReadOnly, nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), "MATCH (n:Person) RETURN n.name AS name", gomock.Nil()).
+			Return(records, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Len(2)).
+			Return(`[{"name":"Alice"},{"name":"Bob"}]`, nil)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+			MaxResultRows:    2,
+		}
+
+		handler := cypher.ReadCypherHandler(deps)
+		request := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"query": "MATCH (n:Person) RETURN n.name AS name",
+				},
+			},
+		}
+
+		result, err := handler(context.Background(), request)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		output, ok := result.StructuredContent.(*tools.QueryResult)
+		if !ok {
+			t.Fatalf("Expected a query result, got %T", result.StructuredContent)
+		}
+		if !slices.Equal(output.Columns, []string{"name"}) {
+			t.Errorf("Expected the name column, got %v", output.Columns)
+		}
+		if len(output.Rows) != 2 || output.Rows[1]["name"] != "Bob" {
+			t.Errorf("Expected the first two rows, got %v", output.Rows)
+		}
+		if output.RowCount != 3 || !output.Truncated {
+			t.Errorf("Expected 3 rows truncated, got rowCount %d truncated %v", output.RowCount, output.Truncated)
+		}
+		if output.Summary == nil || output.Summary.QueryType != "READ_ONLY" {
+			t.Errorf("Expected a READ_ONLY summary, got %v", output.Summary)
+		}
+		if text := result.Content[0].(mcp.TextContent).Text; text != `[{"name":"Alice"},{"name":"Bob"}]` {
+			t.Errorf("Expected the kept rows as text, got %s", text)
+		}
+	})
 }
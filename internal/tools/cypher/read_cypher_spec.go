@@ -5,17 +5,20 @@ package cypher
 
 import (
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
 )
 
 type ReadCypherInput struct {
-	Query  string `json:"query" jsonschema:"The Cypher query to execute"`
-	Params Params `json:"params,omitempty" jsonschema:"Parameters to pass to the Cypher query"`
+	Query    string `json:"query" jsonschema:"The Cypher query to execute"`
+	Params   Params `json:"params,omitempty" jsonschema:"Parameters to pass to the Cypher query"`
+	Database string `json:"database,omitempty" jsonschema:"The database to run the query against, defaults to the configured database. Use list-databases to discover the available databases"`
 }
 
 func ReadCypherSpec() mcp.Tool {
 	return mcp.NewTool("read-cypher",
-		mcp.WithDescription("read-cypher can run only read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...), schema/admin commands, or PROFILE queries, use write-cypher instead."),
+		mcp.WithDescription("read-cypher can run only read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...) or schema/admin commands, use write-cypher instead. To profile a read-only query, use profile-cypher."),
 		mcp.WithInputSchema[ReadCypherInput](),
+		mcp.WithOutputSchema[tools.QueryResult](),
 		mcp.WithTitleAnnotation("Read Cypher"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
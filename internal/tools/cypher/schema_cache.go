@@ -0,0 +1,162 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// schemaRefreshTimeout bounds a background refresh, which outlives the request that triggered it.
+const schemaRefreshTimeout = time.Minute
+
+// SchemaCache caches the rich get-schema output per database, principal and sample size.
+// The principal is part of the key as the schema visible to a user depends on its privileges.
+// Entries older than half the TTL are served while being reloaded in the background,
+// entries older than the TTL are reloaded before being served.
+type SchemaCache struct {
+	ttl        time.Duration
+	mu         sync.Mutex
+	entries    map[schemaCacheKey]*schemaCacheEntry
+	generation uint64 // Incremented by Invalidate, so refreshes started before are not stored
+}
+
+type schemaCacheKey struct {
+	database   string // Empty for the default database
+	principal  string
+	sampleSize int32
+}
+
+type schemaCacheEntry struct {
+	schema     []SchemaItem
+	loadedAt   time.Time
+	refreshing bool
+}
+
+type schemaLoader func(ctx context.Context) ([]SchemaItem, error)
+
+// NewSchemaCache returns a cache keeping schemas for ttl, or nil when ttl is not positive, a nil cache disables caching.
+func NewSchemaCache(ttl time.Duration) *SchemaCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &SchemaCache{
+		ttl:     ttl,
+		entries: make(map[schemaCacheKey]*schemaCacheEntry),
+	}
+}
+
+// newSchemaCacheKey builds the key of the schema seen by the request context.
+func newSchemaCacheKey(ctx context.Context, sampleSize int32) schemaCacheKey {
+	targetDatabase, _ := database.GetTargetDatabase(ctx)
+	return schemaCacheKey{
+		database:   targetDatabase,
+		principal:  auth.Principal(ctx),
+		sampleSize: sampleSize,
+	}
+}
+
+// get returns the cached schema, loading it when missing or expired.
+func (c *SchemaCache) get(ctx context.Context, key schemaCacheKey, load schemaLoader) ([]SchemaItem, error) {
+	c.mu.Lock()
+	entry, found := c.entries[key]
+	if found {
+		age := time.Since(entry.loadedAt)
+		if age < c.ttl {
+			if age >= c.ttl/2 && !entry.refreshing {
+				entry.refreshing = true
+				go c.refreshInBackground(ctx, key, load, c.generation)
+			}
+			c.mu.Unlock()
+			return entry.schema, nil
+		}
+	}
+	c.mu.Unlock()
+	return c.reload(ctx, key, load)
+}
+
+// reload loads the schema and stores it, bypassing the cached entry.
+func (c *SchemaCache) reload(ctx context.Context, key schemaCacheKey, load schemaLoader) ([]SchemaItem, error) {
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	schema, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, schema, generation)
+	return schema, nil
+}
+
+func (c *SchemaCache) refreshInBackground(ctx context.Context, key schemaCacheKey, load schemaLoader, generation uint64) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), schemaRefreshTimeout)
+	defer cancel()
+
+	schema, err := load(ctx)
+	if err != nil {
+		slog.Warn("failed to refresh the cached schema", "database", key.database, "error", err)
+		c.mu.Lock()
+		if entry, found := c.entries[key]; found {
+			entry.refreshing = false
+		}
+		c.mu.Unlock()
+		return
+	}
+	c.store(key, schema, generation)
+}
+
+// store keeps the schema unless the cache was invalidated since the load started.
+func (c *SchemaCache) store(key schemaCacheKey, schema []SchemaItem, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.entries[key] = &schemaCacheEntry{schema: schema, loadedAt: time.Now()}
+}
+
+// Invalidate drops every cached schema. The whole cache is dropped as a query sent to the default
+// database cannot be matched with the entries cached under its name, or the other way around.
+func (c *SchemaCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	clear(c.entries)
+}
+
+// changesSchema reports whether the counters of a write query show a possible schema change
+// invalidating the cached schemas: labels, indexes or constraints added or removed, and relationships
+// created or properties set, which may use a new relationship type or property key.
+func changesSchema(counters neo4j.Counters) bool {
+	return counters.LabelsAdded() > 0 || counters.LabelsRemoved() > 0 ||
+		counters.IndexesAdded() > 0 || counters.IndexesRemoved() > 0 ||
+		counters.ConstraintsAdded() > 0 || counters.ConstraintsRemoved() > 0 ||
+		counters.RelationshipsCreated() > 0 || counters.PropertiesSet() > 0
+}
+
+// compactSchema returns a copy of a rich schema without the counts and the property flags.
+func compactSchema(schema []SchemaItem) []SchemaItem {
+	compact := make([]SchemaItem, len(schema))
+	for i, item := range schema {
+		detail := item.Value
+		detail.Count, detail.Indexed, detail.Unique, detail.Mandatory = 0, nil, nil, nil
+		if detail.Relationships != nil {
+			relationships := make(map[string]Relationship, len(detail.Relationships))
+			for relType, relationship := range detail.Relationships {
+				relationship.Count, relationship.Indexed, relationship.Unique, relationship.Mandatory = 0, nil, nil, nil
+				relationships[relType] = relationship
+			}
+			detail.Relationships = relationships
+		}
+		compact[i] = SchemaItem{Key: item.Key, Value: detail}
+	}
+	return compact
+}
@@ -0,0 +1,198 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/auth"
+	"github.com/neo4j/mcp/internal/database"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func cachedSchemaRecords() []*neo4j.Record {
+	return []*neo4j.Record{
+		{
+			Keys: []string{"key", "value"},
+			Values: []any{"Movie", map[string]any{
+				"type":  "node",
+				"count": int64(3),
+				"properties": map[string]any{
+					"title": map[string]any{"type": "STRING", "indexed": true},
+				},
+			}},
+		},
+	}
+}
+
+func callHandler(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), ctx context.Context, arguments map[string]any) string {
+	t.Helper()
+	result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result == nil || result.IsError {
+		t.Fatalf("Expected success result, got: %v", result)
+	}
+	return result.Content[0].(mcp.TextContent).Text
+}
+
+func TestSchemaCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("disabled without a ttl", func(t *testing.T) {
+		if cache := cypher.NewSchemaCache(0); cache != nil {
+			t.Errorf("Expected no cache, got %v", cache)
+		}
+	})
+
+	t.Run("serves the rich and compact schema from one entry", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(cachedSchemaRecords(), nil).
+			Times(1)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, cypher.NewSchemaCache(time.Hour))
+		rich := callHandler(t, handler, context.Background(), map[string]any{"rich": true})
+		compact := callHandler(t, handler, context.Background(), nil)
+
+		if !strings.Contains(rich, `"count":3`) || !strings.Contains(rich, `"indexed":["title"]`) {
+			t.Errorf("Expected the rich schema, got %s", rich)
+		}
+		if strings.Contains(compact, `"count"`) || strings.Contains(compact, `"indexed"`) {
+			t.Errorf("Expected the compact schema, got %s", compact)
+		}
+	})
+
+	t.Run("refresh bypasses the cache", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(cachedSchemaRecords(), nil).
+			Times(2)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, cypher.NewSchemaCache(time.Hour))
+		callHandler(t, handler, context.Background(), nil)
+		callHandler(t, handler, context.Background(), map[string]any{"refresh": true})
+		callHandler(t, handler, context.Background(), nil)
+	})
+
+	t.Run("keyed by principal and database", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(cachedSchemaRecords(), nil).
+			Times(3)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, cypher.NewSchemaCache(time.Hour))
+		alice := auth.WithBasicAuth(context.Background(), "alice", "secret")
+		bob := auth.WithBasicAuth(context.Background(), "bob", "secret")
+		callHandler(t, handler, alice, nil)
+		callHandler(t, handler, alice, nil)
+		callHandler(t, handler, bob, nil)
+		callHandler(t, handler, database.WithTargetDatabase(alice, "movies"), nil)
+	})
+
+	t.Run("a wrong password misses the cache", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		gomock.InOrder(
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(cachedSchemaRecords(), nil),
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, errors.New("The client is unauthorized due to authentication failure.")),
+		)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, cypher.NewSchemaCache(time.Hour))
+		callHandler(t, handler, auth.WithBasicAuth(context.Background(), "alice", "secret"), nil)
+
+		ctx := auth.WithBasicAuth(context.Background(), "alice", "wrong")
+		result, err := handler(ctx, mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Errorf("Expected the schema to be loaded again and fail with the wrong password, got: %v", result)
+		}
+	})
+
+	t.Run("invalidated by a schema changing write", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(cachedSchemaRecords(), nil).
+			Times(4)
+		gomock.InOrder(
+			mockDB.EXPECT().
+				ExecuteWriteQueryWithSummary(gomock.Any(), "MATCH (m:Movie {title: 'Old'}) DETACH DELETE m", gomock.Nil()).
+				Return([]*neo4j.Record{}, &fakeSummary{counters: &fakeCounters{nodesDeleted: 1}}, nil),
+			mockDB.EXPECT().
+				ExecuteWriteQueryWithSummary(gomock.Any(), "CREATE (:Studio)", gomock.Nil()).
+				Return([]*neo4j.Record{}, &fakeSummary{counters: &fakeCounters{labelsAdded: 1}}, nil),
+			mockDB.EXPECT().
+				ExecuteWriteQueryWithSummary(gomock.Any(), "MATCH (a:Movie), (b:Movie) CREATE (a)-[:SEQUEL_OF]->(b)", gomock.Nil()).
+				Return([]*neo4j.Record{}, &fakeSummary{counters: &fakeCounters{relsCreated: 1}}, nil),
+			mockDB.EXPECT().
+				ExecuteWriteQueryWithSummary(gomock.Any(), "MATCH (m:Movie) SET m.year = 1999", gomock.Nil()).
+				Return([]*neo4j.Record{}, &fakeSummary{counters: &fakeCounters{propertiesSet: 1}}, nil),
+		)
+		mockDB.EXPECT().Neo4jRecordsToJSON(gomock.Any()).Return(`[]`, nil).Times(4)
+
+		cache := cypher.NewSchemaCache(time.Hour)
+		deps := &tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}
+		getSchema := cypher.GetSchemaHandler(deps, 100, cache)
+		write := cypher.WriteCypherHandler(deps, cache)
+
+		callHandler(t, getSchema, context.Background(), nil)
+		callHandler(t, write, context.Background(), map[string]any{"query": "MATCH (m:Movie {title: 'Old'}) DETACH DELETE m"})
+		callHandler(t, getSchema, context.Background(), nil)
+		callHandler(t, write, context.Background(), map[string]any{"query": "CREATE (:Studio)"})
+		callHandler(t, getSchema, context.Background(), nil)
+		// A new relationship type or property key is not reported by the counters, any creation invalidates
+		callHandler(t, write, context.Background(), map[string]any{"query": "MATCH (a:Movie), (b:Movie) CREATE (a)-[:SEQUEL_OF]->(b)"})
+		callHandler(t, getSchema, context.Background(), nil)
+		callHandler(t, write, context.Background(), map[string]any{"query": "MATCH (m:Movie) SET m.year = 1999"})
+		callHandler(t, getSchema, context.Background(), nil)
+	})
+
+	t.Run("refreshed in the background after half the ttl", func(t *testing.T) {
+		refreshed := make(chan struct{})
+		mockDB := db.NewMockService(ctrl)
+		gomock.InOrder(
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(cachedSchemaRecords(), nil),
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(context.Context, string, map[string]any) ([]*neo4j.Record, error) {
+					close(refreshed)
+					return cachedSchemaRecords(), nil
+				}),
+		)
+
+		ttl := 200 * time.Millisecond
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}, 100, cypher.NewSchemaCache(ttl))
+		callHandler(t, handler, context.Background(), nil)
+		time.Sleep(ttl / 2)
+		callHandler(t, handler, context.Background(), nil)
+
+		select {
+		case <-refreshed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected the schema to be refreshed")
+		}
+	})
+}
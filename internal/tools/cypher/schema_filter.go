@@ -0,0 +1,141 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// isEmpty reports whether the filter keeps the whole schema.
+func (f SchemaFilter) isEmpty() bool {
+	return len(f.Labels) == 0 && len(f.RelationshipTypes) == 0 && f.NeighborsOf == "" &&
+		(f.IncludeProperties == nil || *f.IncludeProperties)
+}
+
+// filterSchema returns the slice of the schema selected by the filter.
+// Node items are kept when their label is selected, or when no label is selected and they have a selected relationship type.
+// The relationships of the kept node items are restricted to the selected relationship types, and relationship items
+// are kept when selected and, with labels, used by a kept node item. The schema order is preserved.
+func filterSchema(schema []SchemaItem, filter SchemaFilter) ([]SchemaItem, error) {
+	if filter.isEmpty() {
+		return schema, nil
+	}
+
+	var labels, relationshipTypes map[string]bool
+	if len(filter.Labels) > 0 {
+		labels = toSet(filter.Labels)
+	}
+	if len(filter.RelationshipTypes) > 0 {
+		relationshipTypes = toSet(filter.RelationshipTypes)
+	}
+	if filter.NeighborsOf != "" {
+		index := slices.IndexFunc(schema, func(item SchemaItem) bool {
+			return item.Key == filter.NeighborsOf && item.Value.Type == "node"
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("label %q not found in the schema", filter.NeighborsOf)
+		}
+		neighbors := map[string]bool{filter.NeighborsOf: true}
+		neighborTypes := make(map[string]bool)
+		for relType, relationship := range schema[index].Value.Relationships {
+			if relationshipTypes != nil && !relationshipTypes[relType] {
+				continue
+			}
+			neighborTypes[relType] = true
+			for _, label := range relationship.Labels {
+				neighbors[label] = true
+			}
+		}
+		if labels == nil {
+			labels = make(map[string]bool)
+		}
+		maps.Copy(labels, neighbors)
+		relationshipTypes = neighborTypes
+	}
+
+	// The node items are selected first, as the relationship items depend on the relationships they keep
+	nodes := make(map[string]SchemaDetail)
+	usedTypes := make(map[string]bool)
+	for _, item := range schema {
+		if item.Value.Type != "node" || (labels != nil && !labels[item.Key]) {
+			continue
+		}
+		detail := item.Value
+		if relationshipTypes != nil {
+			detail.Relationships = filterRelationships(detail.Relationships, relationshipTypes)
+			if labels == nil && len(detail.Relationships) == 0 {
+				// Only relationshipTypes is set and the label has none of them
+				continue
+			}
+		}
+		for relType := range detail.Relationships {
+			usedTypes[relType] = true
+		}
+		nodes[item.Key] = detail
+	}
+
+	filtered := make([]SchemaItem, 0, len(nodes))
+	for _, item := range schema {
+		if item.Value.Type == "node" {
+			if detail, found := nodes[item.Key]; found {
+				filtered = append(filtered, SchemaItem{Key: item.Key, Value: detail})
+			}
+			continue
+		}
+		if relationshipTypes != nil && !relationshipTypes[item.Key] {
+			continue
+		}
+		if labels != nil && !usedTypes[item.Key] {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("no label or relationship type of the schema matches the filter")
+	}
+	if filter.IncludeProperties != nil && !*filter.IncludeProperties {
+		for i := range filtered {
+			filtered[i].Value = withoutProperties(filtered[i].Value)
+		}
+	}
+	return filtered, nil
+}
+
+// filterRelationships keeps the relationships of a selected type.
+func filterRelationships(relationships map[string]Relationship, relationshipTypes map[string]bool) map[string]Relationship {
+	kept := make(map[string]Relationship, len(relationships))
+	for relType, relationship := range relationships {
+		if relationshipTypes[relType] {
+			kept[relType] = relationship
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
+
+func withoutProperties(detail SchemaDetail) SchemaDetail {
+	detail.Properties, detail.Indexed, detail.Unique, detail.Mandatory = nil, nil, nil, nil
+	if detail.Relationships != nil {
+		relationships := make(map[string]Relationship, len(detail.Relationships))
+		for relType, relationship := range detail.Relationships {
+			relationship.Properties, relationship.Indexed, relationship.Unique, relationship.Mandatory = nil, nil, nil, nil
+			relationships[relType] = relationship
+		}
+		detail.Relationships = relationships
+	}
+	return detail
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
@@ -0,0 +1,70 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/neo4j/mcp/internal/database"
+)
+
+// schemaTokensQuery lists the labels, relationship types and property keys of the database
+const schemaTokensQuery = `
+        CALL db.labels() YIELD label RETURN 'label' AS kind, label AS name
+        UNION ALL
+        CALL db.relationshipTypes() YIELD relationshipType RETURN 'relationshipType' AS kind, relationshipType AS name
+        UNION ALL
+        CALL db.propertyKeys() YIELD propertyKey RETURN 'propertyKey' AS kind, propertyKey AS name
+    `
+
+// schemaFingerprintInput is the part of the schema the fingerprint covers. The state and the population
+// of the indexes are left out, an index being populated is not a schema change.
+type schemaFingerprintInput struct {
+	Tokens      []string     `json:"tokens"`
+	Indexes     []Index      `json:"indexes"`
+	Constraints []Constraint `json:"constraints"`
+}
+
+// SchemaFingerprint returns a hash of the labels, relationship types, property keys, indexes and constraints
+// of the database targeted by the context, it changes when one of them is added, removed or changed.
+// Unlike get-schema it does not sample the nodes, so it is cheap enough to be computed periodically.
+func SchemaFingerprint(ctx context.Context, dbService database.Service) (string, error) {
+	records, err := dbService.ExecuteReadQuery(ctx, schemaTokensQuery, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the labels, relationship types and property keys: %w", err)
+	}
+	var input schemaFingerprintInput
+	for _, record := range records {
+		kind, _ := recordString(record, "kind")
+		name, _ := recordString(record, "name")
+		input.Tokens = append(input.Tokens, kind+":"+name)
+	}
+	slices.Sort(input.Tokens)
+
+	indexes, err := fetchIndexes(ctx, dbService, showIndexesQuery)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the indexes: %w", err)
+	}
+	for _, index := range indexes {
+		index.State = ""
+		index.PopulationPercent = 0
+		input.Indexes = append(input.Indexes, index)
+	}
+
+	if input.Constraints, err = fetchConstraints(ctx, dbService); err != nil {
+		return "", fmt.Errorf("failed to list the constraints: %w", err)
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize the schema: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
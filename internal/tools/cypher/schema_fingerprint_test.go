@@ -0,0 +1,85 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func isSchemaTokens(query any) bool {
+	q, ok := query.(string)
+	return ok && strings.Contains(q, "db.labels()")
+}
+
+// expectFingerprintQueries mocks the queries of one fingerprint with the given labels and index state.
+func expectFingerprintQueries(mockDB *db.MockService, labels []string, indexState string) {
+	tokenKeys := []string{"kind", "name"}
+	tokens := []*neo4j.Record{{Keys: tokenKeys, Values: []any{"relationshipType", "ACTED_IN"}}}
+	for _, label := range labels {
+		tokens = append(tokens, &neo4j.Record{Keys: tokenKeys, Values: []any{"label", label}})
+	}
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Cond(isSchemaTokens), gomock.Nil()).Return(tokens, nil)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowIndexes), gomock.Nil()).Return([]*neo4j.Record{
+		{
+			Keys:   []string{"name", "type", "entityType", "labelsOrTypes", "properties", "state", "populationPercent", "owningConstraint", "indexConfig"},
+			Values: []any{"person_name", "RANGE", "NODE", []any{"Person"}, []any{"name"}, indexState, 50.0, nil, map[string]any{}},
+		},
+	}, nil)
+	mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowConstraints), gomock.Nil()).Return([]*neo4j.Record{}, nil)
+}
+
+func TestSchemaFingerprint(t *testing.T) {
+	t.Run("ignores the order of the tokens and the state of the indexes", func(t *testing.T) {
+		mockDB := db.NewMockService(gomock.NewController(t))
+		expectFingerprintQueries(mockDB, []string{"Person", "Movie"}, "POPULATING")
+		expectFingerprintQueries(mockDB, []string{"Movie", "Person"}, "ONLINE")
+
+		first, err := cypher.SchemaFingerprint(context.Background(), mockDB)
+		if err != nil {
+			t.Fatalf("SchemaFingerprint() unexpected error: %v", err)
+		}
+		second, err := cypher.SchemaFingerprint(context.Background(), mockDB)
+		if err != nil {
+			t.Fatalf("SchemaFingerprint() unexpected error: %v", err)
+		}
+		if first == "" || first != second {
+			t.Errorf("expected the same fingerprint, got %q and %q", first, second)
+		}
+	})
+
+	t.Run("changes when a label is added", func(t *testing.T) {
+		mockDB := db.NewMockService(gomock.NewController(t))
+		expectFingerprintQueries(mockDB, []string{"Person"}, "ONLINE")
+		expectFingerprintQueries(mockDB, []string{"Person", "Movie"}, "ONLINE")
+
+		first, err := cypher.SchemaFingerprint(context.Background(), mockDB)
+		if err != nil {
+			t.Fatalf("SchemaFingerprint() unexpected error: %v", err)
+		}
+		second, err := cypher.SchemaFingerprint(context.Background(), mockDB)
+		if err != nil {
+			t.Fatalf("SchemaFingerprint() unexpected error: %v", err)
+		}
+		if first == second {
+			t.Error("expected the fingerprint to change")
+		}
+	})
+
+	t.Run("returns the query errors", func(t *testing.T) {
+		mockDB := db.NewMockService(gomock.NewController(t))
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gomock.Cond(isSchemaTokens), gomock.Nil()).Return(nil, errors.New("connection error"))
+
+		if _, err := cypher.SchemaFingerprint(context.Background(), mockDB); err == nil || !strings.Contains(err.Error(), "connection error") {
+			t.Errorf("expected the connection error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,248 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	// nodeTypePropertiesQuery returns a row per label combination and property, propertyName is null for labels without properties
+	nodeTypePropertiesQuery = `
+        CALL db.schema.nodeTypeProperties()
+        YIELD nodeLabels, propertyName, propertyTypes, mandatory
+        RETURN nodeLabels, propertyName, propertyTypes, mandatory
+    `
+	// relTypePropertiesQuery returns a row per relationship type and property, the type is formatted as :`TYPE`
+	relTypePropertiesQuery = `
+        CALL db.schema.relTypeProperties()
+        YIELD relType, propertyName, propertyTypes, mandatory
+        RETURN relType, propertyName, propertyTypes, mandatory
+    `
+	// schemaVisualizationQuery returns the (label)-[type]->(label) combinations, the virtual nodes are named after their label
+	schemaVisualizationQuery = `
+        CALL db.schema.visualization()
+        YIELD relationships
+        UNWIND relationships AS relationship
+        RETURN DISTINCT startNode(relationship).name AS from, type(relationship) AS type, endNode(relationship).name AS to
+    `
+)
+
+// coreTypeNames maps the property types returned by the db.schema procedures to the names used by apoc.meta.schema.
+var coreTypeNames = map[string]string{
+	"String":        "STRING",
+	"Long":          "INTEGER",
+	"Double":        "FLOAT",
+	"Boolean":       "BOOLEAN",
+	"Date":          "DATE",
+	"DateTime":      "DATE_TIME",
+	"LocalDateTime": "LOCAL_DATE_TIME",
+	"Time":          "TIME",
+	"LocalTime":     "LOCAL_TIME",
+	"Duration":      "DURATION",
+	"Point":         "POINT",
+}
+
+// coreSchemaProperty is a property read from db.schema.nodeTypeProperties or db.schema.relTypeProperties.
+type coreSchemaProperty struct {
+	name      string
+	typeName  string
+	mandatory bool
+}
+
+// inferSchemaWithCoreProcedures builds the get-schema output without APOC, using db.schema.nodeTypeProperties,
+// db.schema.relTypeProperties and db.schema.visualization. The items are sorted by key.
+// The core procedures do not report indexes, constraints or counts, in rich mode only the properties
+// present on every node or relationship of a type are listed as mandatory.
+func inferSchemaWithCoreProcedures(ctx context.Context, dbService database.Service, rich bool) ([]SchemaItem, error) {
+	nodeRecords, err := dbService.ExecuteReadQuery(ctx, nodeTypePropertiesQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	relRecords, err := dbService.ExecuteReadQuery(ctx, relTypePropertiesQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	patternRecords, err := dbService.ExecuteReadQuery(ctx, schemaVisualizationQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	labelProperties := make(map[string][]coreSchemaProperty)
+	// labelCombinations counts the label combinations including each label, a property is mandatory
+	// for a label only when it is mandatory for every combination
+	labelCombinations := make(map[string]map[string]bool)
+	for _, record := range nodeRecords {
+		labels := recordStrings(record, "nodeLabels")
+		if len(labels) == 0 {
+			continue
+		}
+		property, hasProperty := recordToCoreSchemaProperty(record)
+		combination := strings.Join(labels, ":")
+		// A node with several labels reports its properties once for the label combination
+		for _, label := range labels {
+			if labelCombinations[label] == nil {
+				labelCombinations[label] = make(map[string]bool)
+			}
+			labelCombinations[label][combination] = true
+			if _, found := labelProperties[label]; !found {
+				labelProperties[label] = nil
+			}
+			if hasProperty {
+				labelProperties[label] = append(labelProperties[label], property)
+			}
+		}
+	}
+
+	typeProperties := make(map[string][]coreSchemaProperty)
+	for _, record := range relRecords {
+		relType, ok := recordString(record, "relType")
+		if !ok {
+			return nil, fmt.Errorf("invalid relationship type returned")
+		}
+		relType = strings.TrimSuffix(strings.TrimPrefix(relType, ":`"), "`")
+		if _, found := typeProperties[relType]; !found {
+			typeProperties[relType] = nil
+		}
+		if property, ok := recordToCoreSchemaProperty(record); ok {
+			typeProperties[relType] = append(typeProperties[relType], property)
+		}
+	}
+
+	labelRelationships := make(map[string]map[string]Relationship)
+	addRelationship := func(label, relType, direction, otherLabel string) {
+		if _, found := labelProperties[label]; !found {
+			labelProperties[label] = nil
+		}
+		if labelRelationships[label] == nil {
+			labelRelationships[label] = make(map[string]Relationship)
+		}
+		relationship, found := labelRelationships[label][relType]
+		if !found {
+			relationship = coreRelationship(direction, typeProperties[relType], rich)
+		}
+		if !slices.Contains(relationship.Labels, otherLabel) {
+			relationship.Labels = append(relationship.Labels, otherLabel)
+		}
+		labelRelationships[label][relType] = relationship
+	}
+	for _, record := range patternRecords {
+		from, fromOk := recordString(record, "from")
+		relType, typeOk := recordString(record, "type")
+		to, toOk := recordString(record, "to")
+		if !fromOk || !typeOk || !toOk {
+			return nil, fmt.Errorf("invalid schema visualization returned")
+		}
+		addRelationship(from, relType, "out", to)
+		addRelationship(to, relType, "in", from)
+	}
+
+	schema := make([]SchemaItem, 0, len(labelProperties)+len(typeProperties))
+	for label, properties := range labelProperties {
+		detail := SchemaDetail{
+			Type:       "node",
+			Properties: corePropertyTypes(properties),
+		}
+		if relationships := labelRelationships[label]; len(relationships) > 0 {
+			detail.Relationships = relationships
+		}
+		if rich {
+			detail.Mandatory = coreMandatoryProperties(properties, max(len(labelCombinations[label]), 1))
+		}
+		schema = append(schema, SchemaItem{Key: label, Value: detail})
+	}
+	for relType, properties := range typeProperties {
+		detail := SchemaDetail{
+			Type:       "relationship",
+			Properties: corePropertyTypes(properties),
+		}
+		if rich {
+			detail.Mandatory = coreMandatoryProperties(properties, 1)
+		}
+		schema = append(schema, SchemaItem{Key: relType, Value: detail})
+	}
+	slices.SortFunc(schema, func(a, b SchemaItem) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return schema, nil
+}
+
+// recordToCoreSchemaProperty reads the property columns, ok is false for rows without property.
+func recordToCoreSchemaProperty(record *neo4j.Record) (coreSchemaProperty, bool) {
+	name, ok := recordString(record, "propertyName")
+	if !ok || name == "" {
+		return coreSchemaProperty{}, false
+	}
+	property := coreSchemaProperty{name: name}
+	typeNames := make([]string, 0, 1)
+	for _, coreType := range recordStrings(record, "propertyTypes") {
+		typeNames = append(typeNames, coreTypeName(coreType))
+	}
+	property.typeName = strings.Join(typeNames, " | ")
+	if raw, found := record.Get("mandatory"); found {
+		property.mandatory, _ = raw.(bool)
+	}
+	return property, true
+}
+
+// coreTypeName converts a db.schema property type, e.g. String or StringArray, to the apoc.meta.schema type name.
+func coreTypeName(coreType string) string {
+	if strings.HasSuffix(coreType, "Array") {
+		return "LIST"
+	}
+	if typeName, found := coreTypeNames[coreType]; found {
+		return typeName
+	}
+	return strings.ToUpper(coreType)
+}
+
+func coreRelationship(direction string, properties []coreSchemaProperty, rich bool) Relationship {
+	relationship := Relationship{
+		Direction:  direction,
+		Properties: corePropertyTypes(properties),
+	}
+	if rich {
+		relationship.Mandatory = coreMandatoryProperties(properties, 1)
+	}
+	return relationship
+}
+
+func corePropertyTypes(properties []coreSchemaProperty) map[string]string {
+	types := make(map[string]string, len(properties))
+	for _, property := range properties {
+		existing, found := types[property.name]
+		switch {
+		case !found:
+			types[property.name] = property.typeName
+		case !slices.Contains(strings.Split(existing, " | "), property.typeName):
+			// The property has another type on another label combination
+			types[property.name] = existing + " | " + property.typeName
+		}
+	}
+	return types
+}
+
+// coreMandatoryProperties returns the sorted names of the properties mandatory in each of the combinations.
+func coreMandatoryProperties(properties []coreSchemaProperty, combinations int) []string {
+	mandatoryCombinations := make(map[string]int)
+	for _, property := range properties {
+		if property.mandatory {
+			mandatoryCombinations[property.name]++
+		}
+	}
+	var mandatory []string
+	for name, count := range mandatoryCombinations {
+		if count >= combinations {
+			mandatory = append(mandatory, name)
+		}
+	}
+	slices.Sort(mandatory)
+	return mandatory
+}
@@ -0,0 +1,177 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func nodeTypePropertyRecord(labels []any, property any, types []any, mandatory bool) *neo4j.Record {
+	return &neo4j.Record{
+		Keys:   []string{"nodeLabels", "propertyName", "propertyTypes", "mandatory"},
+		Values: []any{labels, property, types, mandatory},
+	}
+}
+
+func relTypePropertyRecord(relType string, property any, types []any, mandatory bool) *neo4j.Record {
+	return &neo4j.Record{
+		Keys:   []string{"relType", "propertyName", "propertyTypes", "mandatory"},
+		Values: []any{relType, property, types, mandatory},
+	}
+}
+
+func schemaPatternRecord(from, relType, to string) *neo4j.Record {
+	return &neo4j.Record{Keys: []string{"from", "type", "to"}, Values: []any{from, relType, to}}
+}
+
+func expectCoreSchemaProcedures(mockDB *db.MockService) {
+	mockDB.EXPECT().
+		ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.schema.nodeTypeProperties()")), gomock.Nil()).
+		Return([]*neo4j.Record{
+			nodeTypePropertyRecord([]any{"Person"}, "name", []any{"String"}, true),
+			nodeTypePropertyRecord([]any{"Person"}, "born", []any{"Long"}, false),
+			nodeTypePropertyRecord([]any{"Person", "Actor"}, "name", []any{"String"}, true),
+			nodeTypePropertyRecord([]any{"Person", "Actor"}, "born", []any{"Long"}, true),
+			nodeTypePropertyRecord([]any{"Movie"}, "title", []any{"String"}, true),
+			nodeTypePropertyRecord([]any{"Movie"}, "genres", []any{"StringArray"}, false),
+			nodeTypePropertyRecord([]any{"Studio"}, nil, nil, false),
+		}, nil)
+	mockDB.EXPECT().
+		ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.schema.relTypeProperties()")), gomock.Nil()).
+		Return([]*neo4j.Record{
+			relTypePropertyRecord(":`ACTED_IN`", "roles", []any{"StringArray"}, true),
+			relTypePropertyRecord(":`PRODUCED`", nil, nil, false),
+		}, nil)
+	mockDB.EXPECT().
+		ExecuteReadQuery(gomock.Any(), gomock.Cond(queryContains("db.schema.visualization()")), gomock.Nil()).
+		Return([]*neo4j.Record{
+			schemaPatternRecord("Person", "ACTED_IN", "Movie"),
+			schemaPatternRecord("Actor", "ACTED_IN", "Movie"),
+			schemaPatternRecord("Studio", "PRODUCED", "Movie"),
+		}, nil)
+}
+
+func TestGetSchemaHandlerWithoutAPOC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("infers the schema with the core procedures", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		expectCoreSchemaProcedures(mockDB)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+
+		expected := `[
+			{"key": "ACTED_IN", "value": {"type": "relationship", "properties": {"roles": "LIST"}}},
+			{"key": "Actor", "value": {"type": "node", "properties": {"name": "STRING", "born": "INTEGER"},
+				"relationships": {"ACTED_IN": {"direction": "out", "labels": ["Movie"], "properties": {"roles": "LIST"}}}}},
+			{"key": "Movie", "value": {"type": "node", "properties": {"title": "STRING", "genres": "LIST"},
+				"relationships": {
+					"ACTED_IN": {"direction": "in", "labels": ["Person", "Actor"], "properties": {"roles": "LIST"}},
+					"PRODUCED": {"direction": "in", "labels": ["Studio"]}
+				}}},
+			{"key": "PRODUCED", "value": {"type": "relationship"}},
+			{"key": "Person", "value": {"type": "node", "properties": {"name": "STRING", "born": "INTEGER"},
+				"relationships": {"ACTED_IN": {"direction": "out", "labels": ["Movie"], "properties": {"roles": "LIST"}}}}},
+			{"key": "Studio", "value": {"type": "node",
+				"relationships": {"PRODUCED": {"direction": "out", "labels": ["Movie"]}}}}
+		]`
+		var expectedData, actualData any
+		if err := json.Unmarshal([]byte(expected), &expectedData); err != nil {
+			t.Fatalf("failed to unmarshal expected JSON: %v", err)
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &actualData); err != nil {
+			t.Fatalf("failed to unmarshal actual JSON: %v", err)
+		}
+		expectedFormatted, _ := json.MarshalIndent(expectedData, "", "  ")
+		actualFormatted, _ := json.MarshalIndent(actualData, "", "  ")
+		if string(expectedFormatted) != string(actualFormatted) {
+			t.Errorf("Expected JSON:\n%s\nGot JSON:\n%s", string(expectedFormatted), string(actualFormatted))
+		}
+	})
+
+	t.Run("rich mode lists the properties mandatory for every label combination", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		expectCoreSchemaProcedures(mockDB)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB, SchemaRichOutput: true}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		var schema []cypher.SchemaItem
+		textContent := result.Content[0].(mcp.TextContent)
+		if err := json.Unmarshal([]byte(textContent.Text), &schema); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		mandatory := make(map[string][]string)
+		for _, item := range schema {
+			mandatory[item.Key] = item.Value.Mandatory
+		}
+		// born is only mandatory for the Person:Actor nodes
+		if !slices.Equal(mandatory["Person"], []string{"name"}) || !slices.Equal(mandatory["Actor"], []string{"born", "name"}) {
+			t.Errorf("Unexpected mandatory node properties %v", mandatory)
+		}
+		if !slices.Equal(mandatory["ACTED_IN"], []string{"roles"}) || mandatory["PRODUCED"] != nil {
+			t.Errorf("Unexpected mandatory relationship properties %v", mandatory)
+		}
+	})
+
+	t.Run("empty database", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return([]*neo4j.Record{}, nil).
+			Times(3)
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatal("Expected success result")
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		if textContent.Text != "The get-schema tool executed successfully; however, since the Neo4j instance contains no data, no schema information was returned." {
+			t.Errorf("Unexpected message %q", textContent.Text)
+		}
+	})
+
+	t.Run("core procedure failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Nil()).
+			Return(nil, errors.New("connection failed"))
+
+		handler := cypher.GetSchemaHandler(&tools.ToolDependencies{DBService: mockDB}, 100, nil)
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// SchemaResourceHandler returns a handler function for the neo4j://schema resource
+func SchemaResourceHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache) func(context.Context, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		return readSchemaResource(ctx, request, deps, schemaSampleSize, cache, func(schema []SchemaItem) ([]SchemaItem, error) {
+			return schema, nil
+		})
+	}
+}
+
+// LabelSchemaResourceHandler returns a handler function for the neo4j://schema/labels/{label} resource template
+func LabelSchemaResourceHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache) func(context.Context, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		label, err := resourceArgument(request, "label")
+		if err != nil {
+			return nil, err
+		}
+		return readSchemaResource(ctx, request, deps, schemaSampleSize, cache, func(schema []SchemaItem) ([]SchemaItem, error) {
+			if !hasSchemaItem(schema, label, "node") {
+				return nil, fmt.Errorf("label %q not found in the schema", label)
+			}
+			return filterSchema(schema, SchemaFilter{Labels: []string{label}})
+		})
+	}
+}
+
+// RelationshipSchemaResourceHandler returns a handler function for the neo4j://schema/relationships/{type} resource template
+func RelationshipSchemaResourceHandler(deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache) func(context.Context, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		relType, err := resourceArgument(request, "type")
+		if err != nil {
+			return nil, err
+		}
+		return readSchemaResource(ctx, request, deps, schemaSampleSize, cache, func(schema []SchemaItem) ([]SchemaItem, error) {
+			if !hasSchemaItem(schema, relType, "relationship") {
+				return nil, fmt.Errorf("relationship type %q not found in the schema", relType)
+			}
+			return filterSchema(schema, SchemaFilter{RelationshipTypes: []string{relType}})
+		})
+	}
+}
+
+// readSchemaResource reads the schema like get-schema with its default arguments and returns the selected part as JSON.
+// Unlike tools, resources report failures as errors, which are returned to the client as JSON-RPC errors.
+func readSchemaResource(ctx context.Context, request mcp.ReadResourceRequest, deps *tools.ToolDependencies, schemaSampleSize int32, cache *SchemaCache, selectItems func([]SchemaItem) ([]SchemaItem, error)) ([]mcp.ResourceContents, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return nil, errors.New(errMessage)
+	}
+
+	slog.Info("reading schema resource", "uri", request.Params.URI)
+
+	schema, err := fetchCachedSchema(ctx, deps, schemaSampleSize, deps.SchemaRichOutput, false, cache)
+	if err != nil {
+		slog.Error("failed to retrieve schema", "error", err)
+		return nil, err
+	}
+	selected, err := selectItems(schema)
+	if err != nil {
+		slog.Error("failed to select schema items", "uri", request.Params.URI, "error", err)
+		return nil, err
+	}
+	if selected == nil {
+		selected = []SchemaItem{}
+	}
+
+	jsonData, err := json.Marshal(selected)
+	if err != nil {
+		slog.Error("failed to serialize schema resource", "error", err)
+		return nil, err
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(jsonData),
+		},
+	}, nil
+}
+
+// resourceArgument returns a variable of the URI template, the server passes the matched values as a list.
+func resourceArgument(request mcp.ReadResourceRequest, name string) (string, error) {
+	var value string
+	switch raw := request.Params.Arguments[name].(type) {
+	case []string:
+		if len(raw) == 1 {
+			value = raw[0]
+		}
+	case string:
+		value = raw
+	}
+	if value == "" {
+		return "", fmt.Errorf("%s is missing from the resource URI %q", name, request.Params.URI)
+	}
+	return value, nil
+}
+
+func hasSchemaItem(schema []SchemaItem, key, itemType string) bool {
+	return slices.ContainsFunc(schema, func(item SchemaItem) bool {
+		return item.Key == key && item.Value.Type == itemType
+	})
+}
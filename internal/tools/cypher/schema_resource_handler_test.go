@@ -0,0 +1,134 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func resourceSchemaRecords() []*neo4j.Record {
+	properties := map[string]any{"name": map[string]any{"type": "STRING"}}
+	return []*neo4j.Record{
+		{Keys: []string{"key", "value"}, Values: []any{"Person", map[string]any{"type": "node", "properties": properties, "relationships": map[string]any{
+			"ACTED_IN": map[string]any{"direction": "out", "labels": []any{"Movie"}, "properties": map[string]any{}},
+		}}}},
+		{Keys: []string{"key", "value"}, Values: []any{"Movie", map[string]any{"type": "node", "properties": properties, "relationships": map[string]any{
+			"ACTED_IN": map[string]any{"direction": "in", "labels": []any{"Person"}, "properties": map[string]any{}},
+		}}}},
+		{Keys: []string{"key", "value"}, Values: []any{"Genre", map[string]any{"type": "node", "properties": properties}}},
+		{Keys: []string{"key", "value"}, Values: []any{"ACTED_IN", map[string]any{"type": "relationship", "properties": map[string]any{}}}},
+	}
+}
+
+func readResourceRequest(uri string, arguments map[string]any) mcp.ReadResourceRequest {
+	return mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: uri, Arguments: arguments}}
+}
+
+// resourceKeys returns the keys of the schema items of a resource.
+func resourceKeys(t *testing.T, contents []mcp.ResourceContents) []string {
+	t.Helper()
+	if len(contents) != 1 {
+		t.Fatalf("Expected one content, got %d", len(contents))
+	}
+	textContents, ok := contents[0].(mcp.TextResourceContents)
+	if !ok {
+		t.Fatalf("Expected text contents, got %T", contents[0])
+	}
+	if textContents.MIMEType != "application/json" {
+		t.Errorf("Expected application/json, got %q", textContents.MIMEType)
+	}
+	var schema []cypher.SchemaItem
+	if err := json.Unmarshal([]byte(textContents.Text), &schema); err != nil {
+		t.Fatalf("Failed to unmarshal resource: %v", err)
+	}
+	keys := make([]string, 0, len(schema))
+	for _, item := range schema {
+		keys = append(keys, item.Key)
+	}
+	return keys
+}
+
+func TestSchemaResourceHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newDeps := func() *tools.ToolDependencies {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Any(), gomock.Eq(map[string]any{"sampleSize": int32(100)})).
+			Return(resourceSchemaRecords(), nil)
+		return &tools.ToolDependencies{DBService: mockDB, APOCInstalled: true}
+	}
+
+	t.Run("whole schema", func(t *testing.T) {
+		handler := cypher.SchemaResourceHandler(newDeps(), 100, nil)
+		contents, err := handler(context.Background(), readResourceRequest(cypher.SchemaResourceURI, nil))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		expected := []string{"Person", "Movie", "Genre", "ACTED_IN"}
+		if keys := resourceKeys(t, contents); !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("label", func(t *testing.T) {
+		handler := cypher.LabelSchemaResourceHandler(newDeps(), 100, nil)
+		contents, err := handler(context.Background(), readResourceRequest("neo4j://schema/labels/Person", map[string]any{"label": []string{"Person"}}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		expected := []string{"Person", "ACTED_IN"}
+		if keys := resourceKeys(t, contents); !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("relationship type", func(t *testing.T) {
+		handler := cypher.RelationshipSchemaResourceHandler(newDeps(), 100, nil)
+		contents, err := handler(context.Background(), readResourceRequest("neo4j://schema/relationships/ACTED_IN", map[string]any{"type": []string{"ACTED_IN"}}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		expected := []string{"Person", "Movie", "ACTED_IN"}
+		if keys := resourceKeys(t, contents); !slices.Equal(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		handler := cypher.LabelSchemaResourceHandler(newDeps(), 100, nil)
+		_, err := handler(context.Background(), readResourceRequest("neo4j://schema/labels/ACTED_IN", map[string]any{"label": []string{"ACTED_IN"}}))
+		if err == nil || !strings.Contains(err.Error(), `label "ACTED_IN" not found`) {
+			t.Errorf("Expected a label not found error, got: %v", err)
+		}
+	})
+
+	t.Run("missing template variable", func(t *testing.T) {
+		handler := cypher.RelationshipSchemaResourceHandler(&tools.ToolDependencies{DBService: db.NewMockService(ctrl)}, 100, nil)
+		_, err := handler(context.Background(), readResourceRequest("neo4j://schema/relationships/", nil))
+		if err == nil || !strings.Contains(err.Error(), "type is missing") {
+			t.Errorf("Expected a missing type error, got: %v", err)
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.SchemaResourceHandler(&tools.ToolDependencies{}, 100, nil)
+		_, err := handler(context.Background(), readResourceRequest(cypher.SchemaResourceURI, nil))
+		if err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
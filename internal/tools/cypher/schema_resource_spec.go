@@ -0,0 +1,47 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// SchemaResourceURI is the URI of the whole schema resource
+	SchemaResourceURI = "neo4j://schema"
+	// LabelSchemaResourceURITemplate is the URI template of the schema of a node label
+	LabelSchemaResourceURITemplate = "neo4j://schema/labels/{label}"
+	// RelationshipSchemaResourceURITemplate is the URI template of the schema of a relationship type
+	RelationshipSchemaResourceURITemplate = "neo4j://schema/relationships/{type}"
+)
+
+// IsSchemaResourceURI reports whether the URI is the one of a schema resource, of the whole schema or of a label or relationship type.
+func IsSchemaResourceURI(uri string) bool {
+	return uri == SchemaResourceURI ||
+		strings.HasPrefix(uri, SchemaResourceURI+"/labels/") ||
+		strings.HasPrefix(uri, SchemaResourceURI+"/relationships/")
+}
+
+func SchemaResourceSpec() mcp.Resource {
+	return mcp.NewResource(SchemaResourceURI, "schema",
+		mcp.WithResourceDescription("The schema of the Neo4j database, as returned by the get-schema tool: node labels, relationship types, their properties and how they connect."),
+		mcp.WithMIMEType("application/json"),
+	)
+}
+
+func LabelSchemaResourceSpec() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(LabelSchemaResourceURITemplate, "label-schema",
+		mcp.WithTemplateDescription("The schema of a node label: its properties, its relationships and the relationship types they use."),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+}
+
+func RelationshipSchemaResourceSpec() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(RelationshipSchemaResourceURITemplate, "relationship-schema",
+		mcp.WithTemplateDescription("The schema of a relationship type: its properties and the node labels it connects."),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// GraphNode is a JSON tagged representation of a neo4j.Node.
+type GraphNode struct {
+	ElementID  string         `json:"elementId"`
+	Labels     []string       `json:"labels"`
+	Properties map[string]any `json:"properties"`
+}
+
+// GraphRelationship is a JSON tagged representation of a neo4j.Relationship.
+type GraphRelationship struct {
+	ElementID      string         `json:"elementId"`
+	Type           string         `json:"type"`
+	StartElementID string         `json:"startElementId"`
+	EndElementID   string         `json:"endElementId"`
+	Properties     map[string]any `json:"properties"`
+}
+
+// Subgraph is a de-duplicated set of nodes and relationships.
+type Subgraph struct {
+	Nodes         []GraphNode         `json:"nodes"`
+	Relationships []GraphRelationship `json:"relationships"`
+	Truncated     bool                `json:"truncated"`
+
+	nodeIndex         map[string]bool
+	relationshipIndex map[string]bool
+}
+
+func newSubgraph() *Subgraph {
+	return &Subgraph{
+		Nodes:             make([]GraphNode, 0),
+		Relationships:     make([]GraphRelationship, 0),
+		nodeIndex:         make(map[string]bool),
+		relationshipIndex: make(map[string]bool),
+	}
+}
+
+// hasNode reports whether the node with the given element id is part of the subgraph.
+func (g *Subgraph) hasNode(elementID string) bool {
+	return g.nodeIndex[elementID]
+}
+
+// addNode adds the node if it is not already part of the subgraph, it returns true if the node was added.
+func (g *Subgraph) addNode(node neo4j.Node) bool {
+	if g.nodeIndex[node.ElementId] {
+		return false
+	}
+	g.nodeIndex[node.ElementId] = true
+	g.Nodes = append(g.Nodes, nodeToGraphNode(node))
+	return true
+}
+
+// addRelationship adds the relationship if it is not already part of the subgraph.
+func (g *Subgraph) addRelationship(relationship neo4j.Relationship) {
+	if g.relationshipIndex[relationship.ElementId] {
+		return
+	}
+	g.relationshipIndex[relationship.ElementId] = true
+	g.Relationships = append(g.Relationships, relationshipToGraphRelationship(relationship))
+}
+
+// validateIdentifier checks that a label, relationship type or property name given as argument can be quoted
+// with quoteIdentifier: it is not empty and has no control character.
+func validateIdentifier(kind, name string) error {
+	if name == "" || strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return fmt.Errorf("invalid %s %q, it must be a non-empty name without control characters", kind, name)
+	}
+	return nil
+}
+
+// quoteIdentifier escapes a label, relationship type or property name so it can be used in a Cypher query.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// GraphPath is a JSON tagged representation of a path, Cost is only set for weighted paths.
+type GraphPath struct {
+	Length        int                 `json:"length"`
+	Cost          *float64            `json:"cost,omitempty"`
+	Nodes         []GraphNode         `json:"nodes"`
+	Relationships []GraphRelationship `json:"relationships"`
+}
+
+func pathToGraphPath(path neo4j.Path) GraphPath {
+	graphPath := GraphPath{
+		Length:        len(path.Relationships),
+		Nodes:         make([]GraphNode, 0, len(path.Nodes)),
+		Relationships: make([]GraphRelationship, 0, len(path.Relationships)),
+	}
+	for _, node := range path.Nodes {
+		graphPath.Nodes = append(graphPath.Nodes, nodeToGraphNode(node))
+	}
+	for _, relationship := range path.Relationships {
+		graphPath.Relationships = append(graphPath.Relationships, relationshipToGraphRelationship(relationship))
+	}
+	return graphPath
+}
+
+func nodeToGraphNode(node neo4j.Node) GraphNode {
+	return GraphNode{
+		ElementID:  node.ElementId,
+		Labels:     node.Labels,
+		Properties: node.Props,
+	}
+}
+
+func relationshipToGraphRelationship(relationship neo4j.Relationship) GraphRelationship {
+	return GraphRelationship{
+		ElementID:      relationship.ElementId,
+		Type:           relationship.Type,
+		StartElementID: relationship.StartElementId,
+		EndElementID:   relationship.EndElementId,
+		Properties:     relationship.Props,
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+const (
+	defaultVectorSearchTopK = 10
+	maxVectorSearchTopK     = 1000
+
+	// vectorSearchNodesQuery queries a node vector index, the filter is applied to the nearest neighbours
+	vectorSearchNodesQuery = `
+        CALL db.index.vector.queryNodes($indexName, $topK, $vector)
+        YIELD node, score
+        WHERE all(key IN keys($filter) WHERE node[key] = $filter[key])
+        RETURN node, score
+        ORDER BY score DESC
+    `
+	// vectorSearchRelationshipsQuery queries a relationship vector index, the filter is applied to the nearest neighbours
+	vectorSearchRelationshipsQuery = `
+        CALL db.index.vector.queryRelationships($indexName, $topK, $vector)
+        YIELD relationship, score
+        WHERE all(key IN keys($filter) WHERE relationship[key] = $filter[key])
+        RETURN relationship, score
+        ORDER BY score DESC
+    `
+)
+
+// VectorSearchHandler returns a handler function for the vector-search tool
+func VectorSearchHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleVectorSearch(ctx, request, deps)
+	}
+}
+
+func handleVectorSearch(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	if deps.DBService == nil {
+		errMessage := "database service is not initialized"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args VectorSearchInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.IndexName == "" {
+		errMessage := "indexName parameter is required and cannot be empty"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if len(args.Vector) == 0 {
+		errMessage := "vector parameter is required and cannot be empty"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if args.TopK == 0 {
+		args.TopK = defaultVectorSearchTopK
+	}
+	if args.TopK < 0 || args.TopK > maxVectorSearchTopK {
+		errMessage := fmt.Sprintf("topK must be between 1 and %d", maxVectorSearchTopK)
+		slog.Error(errMessage, "topK", args.TopK)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	if args.Filter == nil {
+		args.Filter = Params{}
+	}
+
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	indexes, err := fetchIndexes(ctx, deps.DBService, showVectorIndexesQuery)
+	if err != nil {
+		slog.Error("failed to retrieve vector indexes", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	index, err := findIndex(indexes, "vector", args.IndexName)
+	if err != nil {
+		slog.Error("vector index not found", "index", args.IndexName)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := validateQueryVector(index, args.Vector); err != nil {
+		slog.Error("invalid query vector", "index", index.Name, "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	query := vectorSearchNodesQuery
+	if index.EntityType == "RELATIONSHIP" {
+		query = vectorSearchRelationshipsQuery
+	}
+
+	slog.Info("searching vector index", "index", index.Name, "topK", args.TopK)
+
+	records, err := deps.DBService.ExecuteReadQuery(ctx, query, map[string]any{
+		"indexName": index.Name,
+		"topK":      args.TopK,
+		"vector":    args.Vector,
+		"filter":    map[string]any(args.Filter),
+	})
+	if err != nil {
+		slog.Error("error executing vector search", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result, records := deps.NewQueryResult(records)
+	response, err := deps.FormatRecords(ctx, records)
+	if err != nil {
+		slog.Error("error formatting vector search results", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultStructured(result, response), nil
+}
+
+// validateQueryVector checks the query vector against the configuration of the vector index.
+func validateQueryVector(index Index, vector []float64) error {
+	if index.State != "ONLINE" {
+		return fmt.Errorf("vector index %q is not online, current state is %s", index.Name, index.State)
+	}
+	if dimensions, ok := toFloat64(index.IndexConfig["vector.dimensions"]); ok && int(dimensions) != len(vector) {
+		return fmt.Errorf("vector index %q expects vectors of %d dimensions, got %d", index.Name, int(dimensions), len(vector))
+	}
+	similarity, _ := index.IndexConfig["vector.similarity_function"].(string)
+	if strings.EqualFold(similarity, "COSINE") && isZeroVector(vector) {
+		return fmt.Errorf("vector index %q uses the cosine similarity, which is undefined for a zero vector", index.Name)
+	}
+	return nil
+}
+
+func isZeroVector(vector []float64) bool {
+	for _, value := range vector {
+		if value != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,224 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+func isShowVectorIndexes(query any) bool {
+	q, ok := query.(string)
+	return ok && strings.Contains(q, "SHOW INDEXES") && strings.Contains(q, "type = 'VECTOR'")
+}
+
+func vectorIndexRecords() []*neo4j.Record {
+	keys := []string{"name", "type", "entityType", "labelsOrTypes", "properties", "state", "populationPercent", "owningConstraint", "indexConfig"}
+	return []*neo4j.Record{
+		{
+			Keys: keys,
+			Values: []any{"movie_embedding", "VECTOR", "NODE", []any{"Movie"}, []any{"embedding"}, "ONLINE", 100.0, nil,
+				map[string]any{"vector.dimensions": int64(3), "vector.similarity_function": "COSINE"}},
+		},
+		{
+			Keys: keys,
+			Values: []any{"review_embedding", "VECTOR", "RELATIONSHIP", []any{"REVIEWED"}, []any{"embedding"}, "ONLINE", 100.0, nil,
+				map[string]any{"vector.dimensions": int64(2), "vector.similarity_function": "EUCLIDEAN"}},
+		},
+		{
+			Keys: keys,
+			Values: []any{"person_embedding", "VECTOR", "NODE", []any{"Person"}, []any{"embedding"}, "POPULATING", 12.0, nil,
+				map[string]any{"vector.dimensions": int64(3), "vector.similarity_function": "COSINE"}},
+		},
+	}
+}
+
+func vectorSearchRequest(arguments map[string]any) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+}
+
+func TestVectorSearchHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful node search with filter", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowVectorIndexes), gomock.Nil()).
+			Return(vectorIndexRecords(), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "db.index.vector.queryNodes")
+			}), map[string]any{
+				"indexName": "movie_embedding",
+				"topK":      5,
+				"vector":    []float64{0.1, 0.2, 0.3},
+				"filter":    map[string]any{"year": int64(1999)},
+			}).
+			Return([]*neo4j.Record{}, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[{"node": {"title": "The Matrix"}, "score": 0.93}]`, nil)
+
+		handler := cypher.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), vectorSearchRequest(map[string]any{
+			"indexName": "movie_embedding",
+			"vector":    []any{0.1, 0.2, 0.3},
+			"topK":      5,
+			"filter":    map[string]any{"year": 1999},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		textContent := result.Content[0].(mcp.TextContent)
+		if !strings.Contains(textContent.Text, "The Matrix") {
+			t.Errorf("Expected formatted records, got %s", textContent.Text)
+		}
+	})
+
+	t.Run("relationship index with default topK", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowVectorIndexes), gomock.Nil()).
+			Return(vectorIndexRecords(), nil)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(func(query any) bool {
+				return strings.Contains(query.(string), "db.index.vector.queryRelationships")
+			}), map[string]any{
+				"indexName": "review_embedding",
+				"topK":      10,
+				"vector":    []float64{0, 0},
+				"filter":    map[string]any{},
+			}).
+			Return([]*neo4j.Record{}, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[]`, nil)
+
+		handler := cypher.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), vectorSearchRequest(map[string]any{
+			"indexName": "review_embedding",
+			"vector":    []any{0, 0},
+		}))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+	})
+
+	invalidCases := []struct {
+		name      string
+		arguments map[string]any
+		contains  string
+	}{
+		{
+			name:      "unknown index",
+			arguments: map[string]any{"indexName": "missing", "vector": []any{0.1, 0.2, 0.3}},
+			contains:  "available vector indexes: movie_embedding, review_embedding, person_embedding",
+		},
+		{
+			name:      "dimension mismatch",
+			arguments: map[string]any{"indexName": "movie_embedding", "vector": []any{0.1, 0.2}},
+			contains:  "expects vectors of 3 dimensions, got 2",
+		},
+		{
+			name:      "zero vector with cosine similarity",
+			arguments: map[string]any{"indexName": "movie_embedding", "vector": []any{0, 0, 0}},
+			contains:  "cosine",
+		},
+		{
+			name:      "index not online",
+			arguments: map[string]any{"indexName": "person_embedding", "vector": []any{0.1, 0.2, 0.3}},
+			contains:  "not online",
+		},
+	}
+	for _, tc := range invalidCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDB := db.NewMockService(ctrl)
+			mockDB.EXPECT().
+				ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowVectorIndexes), gomock.Nil()).
+				Return(vectorIndexRecords(), nil)
+
+			handler := cypher.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+			result, err := handler(context.Background(), vectorSearchRequest(tc.arguments))
+			if err != nil {
+				t.Fatalf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Fatalf("Expected error result, got: %v", result)
+			}
+			textContent := result.Content[0].(mcp.TextContent)
+			if !strings.Contains(textContent.Text, tc.contains) {
+				t.Errorf("Expected error to contain %q, got %q", tc.contains, textContent.Text)
+			}
+		})
+	}
+
+	t.Run("missing arguments", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		handler := cypher.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+
+		for _, arguments := range []map[string]any{
+			{"vector": []any{0.1}},
+			{"indexName": "movie_embedding"},
+			{"indexName": "movie_embedding", "vector": []any{0.1}, "topK": 5000},
+		} {
+			result, err := handler(context.Background(), vectorSearchRequest(arguments))
+			if err != nil {
+				t.Errorf("Expected no error from handler, got: %v", err)
+			}
+			if result == nil || !result.IsError {
+				t.Errorf("Expected error result for arguments %v", arguments)
+			}
+		}
+	})
+
+	t.Run("index query failure", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteReadQuery(gomock.Any(), gomock.Cond(isShowVectorIndexes), gomock.Nil()).
+			Return(nil, errors.New("connection failed"))
+
+		handler := cypher.VectorSearchHandler(&tools.ToolDependencies{DBService: mockDB})
+		result, err := handler(context.Background(), vectorSearchRequest(map[string]any{
+			"indexName": "movie_embedding",
+			"vector":    []any{0.1, 0.2, 0.3},
+		}))
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result")
+		}
+	})
+
+	t.Run("nil database service", func(t *testing.T) {
+		handler := cypher.VectorSearchHandler(&tools.ToolDependencies{DBService: nil})
+		result, err := handler(context.Background(), mcp.CallToolRequest{})
+		if err != nil {
+			t.Errorf("Expected no error from handler, got: %v", err)
+		}
+		if result == nil || !result.IsError {
+			t.Error("Expected error result for nil database service")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+type VectorSearchInput struct {
+	IndexName string    `json:"indexName" jsonschema:"The name of the vector index to search, use get-indexes-and-constraints to discover the vector indexes"`
+	Vector    []float64 `json:"vector" jsonschema:"The query vector, its dimensions must match the dimensions of the index"`
+	TopK      int       `json:"topK,omitempty" jsonschema:"The number of nearest neighbours to retrieve, defaults to 10"`
+	Filter    Params    `json:"filter,omitempty" jsonschema:"Property values the matched nodes or relationships must have, applied after the nearest neighbours are retrieved"`
+	Database  string    `json:"database,omitempty" jsonschema:"The database to search, defaults to the configured database. Use list-databases to discover the available databases"`
+}
+
+func VectorSearchSpec() mcp.Tool {
+	return mcp.NewTool("vector-search",
+		mcp.WithDescription(`
+		Search a Neo4j vector index for the nodes or relationships whose embedding is the most similar to the query vector.
+		Returns the matched nodes or relationships with their similarity score, ordered from the most to the least similar.
+		The vector dimensions are checked against the index before the search runs.
+		The optional filter is applied to the topK nearest neighbours, so fewer than topK results may be returned.`),
+		mcp.WithInputSchema[VectorSearchInput](),
+		mcp.WithOutputSchema[tools.QueryResult](),
+		mcp.WithTitleAnnotation("Vector Search"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(true),
+	)
+}
@@ -0,0 +1,292 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package cypher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// confirmField is the boolean the user sets to run a write query.
+const confirmField = "confirm"
+
+// confirmationSchema is the form of the elicitation asking the user to run a write query.
+var confirmationSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		confirmField: map[string]any{
+			"type":        "boolean",
+			"title":       "Run the query",
+			"description": "Run the write query and commit its changes to the database",
+		},
+	},
+	"required": []string{confirmField},
+}
+
+// destructiveCounters are the update counters of the changes deleting existing data or schema.
+var destructiveCounters = []string{
+	"nodesDeleted",
+	"relationshipsDeleted",
+	"labelsRemoved",
+	"indexesRemoved",
+	"constraintsRemoved",
+}
+
+// modificationCounters are the update counters of the changes modifying existing data. They also count the properties
+// and labels of the created entities, the ones the creations could account for are not counted, see creationAllowance.
+var modificationCounters = []string{
+	"propertiesSet",
+	"labelsAdded",
+}
+
+// creationCounters are the update counters of the created entities.
+var creationCounters = []string{
+	"nodesCreated",
+	"relationshipsCreated",
+}
+
+// creationOperators are the plan operators creating entities, their details show the patterns they create.
+var creationOperators = []string{"Create", "Merge"}
+
+// unrollbackableOperators are the plan operators whose changes escape the rollback of a dry run:
+// procedures may commit on their own, e.g. apoc.periodic.iterate, and CALL { } IN TRANSACTIONS commits each batch.
+var unrollbackableOperators = []string{"ProcedureCall", "TransactionApply", "TransactionForeach"}
+
+// additiveSchemaOperators are the plan operators of the schema commands adding indexes and constraints,
+// DoNothingIfExists is planned for their IF NOT EXISTS variants.
+var additiveSchemaOperators = []string{"CreateIndex", "CreateConstraint", "DoNothingIfExists"}
+
+// writeImpact is what a write query would change, measured by a rolled-back dry run.
+type writeImpact struct {
+	counters map[string]int // the non-zero update counters of the dry run
+	changes  int            // the number of deletions and modifications, see destructiveCounters and modificationCounters
+	unknown  string         // why the query was not dry run, empty when it was
+}
+
+// confirmWrite asks the user to confirm a write query deleting or modifying more than WriteConfirmationThreshold
+// entities, with MCP elicitation. Clients without elicitation support get the configured fallback.
+// It returns the tool error to return instead of running the query, nil when the query may run.
+func confirmWrite(ctx context.Context, deps *tools.ToolDependencies, query string, params map[string]any) *mcp.CallToolResult {
+	canElicit := supportsElicitation(ctx)
+	if !canElicit && !deps.DenyUnconfirmedWrites {
+		// The query runs whatever its impact, no need to measure it
+		return nil
+	}
+
+	impact, err := measureWriteImpact(ctx, deps.DBService, query, params)
+	if err != nil {
+		slog.Error("error measuring the impact of the write query", "error", err)
+		return mcp.NewToolResultError(err.Error())
+	}
+	if impact.unknown == "" && impact.changes <= deps.WriteConfirmationThreshold {
+		return nil
+	}
+
+	description := impact.describe(deps.WriteConfirmationThreshold)
+	if !canElicit {
+		slog.Warn("refused an unconfirmed write query", "query", query, "impact", description)
+		return mcp.NewToolResultError(fmt.Sprintf("write-cypher refused the query: %s, and the client cannot ask the user to confirm it. "+
+			"Split the query into smaller changes or run it outside of the MCP server.", description))
+	}
+
+	result, err := server.ServerFromContext(ctx).RequestElicitation(ctx, mcp.ElicitationRequest{
+		Params: mcp.ElicitationParams{
+			Message:         fmt.Sprintf("write-cypher is about to run this query:\n\n%s\n\n%s. Run it?", query, capitalize(description)),
+			RequestedSchema: confirmationSchema,
+		},
+	})
+	if err != nil {
+		slog.Error("error asking the user to confirm the write query", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to ask the user to confirm the write query: %s", err.Error()))
+	}
+	if result.Action != mcp.ElicitationResponseActionAccept || !confirmed(result.Content) {
+		slog.Info("the user did not confirm the write query", "action", result.Action)
+		return mcp.NewToolResultError(fmt.Sprintf("the user did not confirm the write query, nothing was changed: %s", description))
+	}
+	slog.Info("the user confirmed the write query", "impact", description)
+	return nil
+}
+
+// supportsElicitation reports whether the client of the request declared the elicitation capability.
+func supportsElicitation(ctx context.Context) bool {
+	if server.ServerFromContext(ctx) == nil {
+		return false
+	}
+	session := server.ClientSessionFromContext(ctx)
+	if _, ok := session.(server.SessionWithElicitation); !ok {
+		return false
+	}
+	sessionWithClientInfo, ok := session.(server.SessionWithClientInfo)
+	return ok && sessionWithClientInfo.GetClientCapabilities().Elicitation != nil
+}
+
+// measureWriteImpact classifies the query with EXPLAIN, then dry runs the queries that can be rolled back.
+func measureWriteImpact(ctx context.Context, dbService database.Service, query string, params map[string]any) (*writeImpact, error) {
+	summary, err := dbService.ExplainQuery(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	plan := summary.Plan()
+	switch summary.QueryType() {
+	case neo4j.QueryTypeReadOnly:
+		return &writeImpact{}, nil
+	case neo4j.QueryTypeSchemaWrite:
+		if plan != nil && onlyOperators(plan, additiveSchemaOperators) {
+			return &writeImpact{}, nil
+		}
+		return &writeImpact{unknown: "schema commands dropping indexes or constraints and administration commands are not dry run"}, nil
+	}
+	if operator := findOperator(plan, unrollbackableOperators); operator != "" {
+		return &writeImpact{unknown: fmt.Sprintf("the query uses %s, whose changes cannot be rolled back by a dry run", operator)}, nil
+	}
+
+	summary, err = dbService.DryRunWriteQuery(ctx, query, params)
+	if err != nil {
+		// The query itself may be fine, e.g. when it cannot run in an explicit transaction
+		return &writeImpact{unknown: fmt.Sprintf("the dry run failed (%s)", err.Error())}, nil
+	}
+	impact := &writeImpact{counters: tools.NewQueryCounters(summary.Counters())}
+	for _, counter := range destructiveCounters {
+		impact.changes += impact.counters[counter]
+	}
+	created, modifications := 0, 0
+	for _, counter := range creationCounters {
+		created += impact.counters[counter]
+	}
+	for _, counter := range modificationCounters {
+		modifications += impact.counters[counter]
+	}
+	impact.changes += max(0, modifications-creationAllowance(plan, created))
+	return impact, nil
+}
+
+// creationAllowance returns how many of the properties set and labels added the created entities could account for:
+// each created entity is assumed to set as many as the largest pattern of the creation operators of the plan.
+// The properties of a map parameter, e.g. CREATE (n $props), do not show in the plan and are not accounted for.
+func creationAllowance(plan neo4j.Plan, created int) int {
+	if created == 0 {
+		return 0
+	}
+	return created * largestCreatedPattern(plan)
+}
+
+// largestCreatedPattern returns the largest number of labels, relationship types and properties of a pattern
+// created by the plan, read from the details of its creation operators.
+func largestCreatedPattern(plan neo4j.Plan) int {
+	if plan == nil {
+		return 0
+	}
+	largest := 0
+	isCreation := slices.ContainsFunc(creationOperators, func(name string) bool { return strings.HasPrefix(plan.Operator(), name) })
+	if details, ok := plan.Arguments()["Details"].(string); ok && isCreation {
+		largest = patternSize(details)
+	}
+	for _, child := range plan.Children() {
+		largest = max(largest, largestCreatedPattern(child))
+	}
+	return largest
+}
+
+// patternSize returns the largest number of labels, relationship types and property keys of the node
+// and relationship patterns of an operator details, e.g. 2 for (n:Person {name: $name}).
+func patternSize(details string) int {
+	largest, current, depth := 0, 0, 0
+	var quote rune
+	for i, c := range details {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '{':
+			depth++
+			if depth == 1 && !strings.HasPrefix(strings.TrimSpace(details[i+1:]), "}") {
+				current++
+			}
+		case c == '}':
+			depth--
+		case c == ',' && depth == 1:
+			current++
+		case c == ':' && depth == 0:
+			current++
+		case (c == '(' || c == '[') && depth == 0:
+			current = 0
+		}
+		largest = max(largest, current)
+	}
+	return largest
+}
+
+// findOperator returns the first operator of the plan tree starting with one of the names, empty when none does.
+func findOperator(plan neo4j.Plan, names []string) string {
+	if plan == nil {
+		return ""
+	}
+	for _, name := range names {
+		if strings.HasPrefix(plan.Operator(), name) {
+			return name
+		}
+	}
+	for _, child := range plan.Children() {
+		if operator := findOperator(child, names); operator != "" {
+			return operator
+		}
+	}
+	return ""
+}
+
+// onlyOperators reports whether every operator of the plan is one of the names.
+func onlyOperators(plan neo4j.Plan, names []string) bool {
+	if !slices.ContainsFunc(names, func(name string) bool { return strings.HasPrefix(plan.Operator(), name) }) {
+		return false
+	}
+	for _, child := range plan.Children() {
+		if !onlyOperators(child, names) {
+			return false
+		}
+	}
+	return true
+}
+
+// describe summarizes the impact for the user.
+func (i *writeImpact) describe(threshold int) string {
+	if i.unknown != "" {
+		return "its impact could not be measured: " + i.unknown
+	}
+	counters := make([]string, 0, len(i.counters))
+	for _, name := range slices.Sorted(maps.Keys(i.counters)) {
+		counters = append(counters, fmt.Sprintf("%s=%d", name, i.counters[name]))
+	}
+	return fmt.Sprintf("a rolled-back dry run reported %d deletions or modifications, more than the %d allowed without confirmation (%s)",
+		i.changes, threshold, strings.Join(counters, ", "))
+}
+
+// confirmed reports whether the elicitation content sets the confirm field.
+func confirmed(content any) bool {
+	values, ok := content.(map[string]any)
+	if !ok {
+		return false
+	}
+	confirm, ok := values[confirmField].(bool)
+	return ok && confirm
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -11,13 +11,15 @@ import (
 	"github.com/neo4j/mcp/internal/tools"
 )
 
-func WriteCypherHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// WriteCypherHandler returns a handler function for the write-cypher tool,
+// the schema cache is invalidated when a query changes the schema, it may be nil.
+func WriteCypherHandler(deps *tools.ToolDependencies, schemaCache *SchemaCache) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleWriteCypher(ctx, request, deps)
+		return handleWriteCypher(ctx, request, deps, schemaCache)
 	}
 }
 
-func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies, schemaCache *SchemaCache) (*mcp.CallToolResult, error) {
 	if deps.DBService == nil {
 		errMessage := "Database service is not initialized"
 		slog.Error(errMessage)
@@ -34,6 +36,12 @@ func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, deps *t
 	Query := args.Query
 	Params := args.Params
 
+	ctx, err := deps.WithTargetDatabase(ctx, args.Database)
+	if err != nil {
+		slog.Error("error selecting target database", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	// Validate that query is not empty
 	if Query == "" {
 		errMessage := "Query parameter is required and cannot be empty"
@@ -41,20 +49,42 @@ func handleWriteCypher(ctx context.Context, request mcp.CallToolRequest, deps *t
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
+	if deps.WriteConfirmationThreshold > 0 {
+		if errResult := confirmWrite(ctx, deps, Query, Params); errResult != nil {
+			return errResult, nil
+		}
+	}
+
 	slog.Info("executing write cypher query", "query", Query)
 
+	ctx, stopProgress := tools.NewProgressReporter(ctx, request).TrackQuery(ctx, deps, Query)
+	defer stopProgress()
+
 	// Execute the Cypher query using the database service
-	records, err := deps.DBService.ExecuteWriteQuery(ctx, Query, Params)
+	records, summary, err := deps.DBService.ExecuteWriteQueryWithSummary(ctx, Query, Params)
 	if err != nil {
 		slog.Error("error executing cypher query", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	response, err := deps.DBService.Neo4jRecordsToJSON(records)
+	if schemaCache != nil && summary != nil && changesSchema(summary.Counters()) {
+		slog.Info("write query changed the schema, invalidating the schema cache")
+		schemaCache.Invalidate()
+	}
+
+	result, records := deps.NewQueryResult(records)
+	if summary != nil {
+		result.Summary = &tools.QuerySummary{
+			QueryType: queryTypeName(summary.QueryType()),
+			Counters:  tools.NewQueryCounters(summary.Counters()),
+		}
+	}
+
+	response, err := deps.FormatRecords(ctx, records)
 	if err != nil {
 		slog.Error("error formatting query results", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return mcp.NewToolResultStructured(result, response), nil
 }
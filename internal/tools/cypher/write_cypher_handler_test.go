@@ -5,10 +5,14 @@ package cypher_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"maps"
+	"strings"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	analytics "github.com/neo4j/mcp/internal/analytics/mocks"
 	db "github.com/neo4j/mcp/internal/database/mocks"
 	"github.com/neo4j/mcp/internal/tools"
@@ -26,8 +30,8 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("successful cypher execution with parameters", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}).
-			Return([]*neo4j.Record{}, nil)
+			ExecuteWriteQueryWithSummary(gomock.Any(), "MATCH (n:Person {name: $name}) RETURN n", map[string]any{"name": "Alice"}).
+			Return([]*neo4j.Record{}, nil, nil)
 		mockDB.EXPECT().
 			Neo4jRecordsToJSON(gomock.Any()).
 			Return(`[{"n": {"name": "Alice"}}]`, nil)
@@ -37,7 +41,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -60,8 +64,8 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("successful cypher execution without parameters", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
+			ExecuteWriteQueryWithSummary(gomock.Any(), "MATCH (n) RETURN count(n)", gomock.Nil()).
+			Return([]*neo4j.Record{}, nil, nil)
 		mockDB.EXPECT().
 			Neo4jRecordsToJSON(gomock.Any()).
 			Return(`[{"count(n)": 42}]`, nil)
@@ -71,7 +75,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -98,7 +102,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		// Test with invalid argument structure that should cause BindArguments to fail
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
@@ -118,7 +122,7 @@ func TestWriteCypherHandler(t *testing.T) {
 
 	t.Run("missing required arguments", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		// The handler should NOT call ExecuteWriteQuery when query is empty
+		// The handler should NOT call ExecuteWriteQueryWithSummary when query is empty
 		// No expectations set for mockDB since it shouldn't be called
 
 		deps := &tools.ToolDependencies{
@@ -126,7 +130,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -148,7 +152,7 @@ func TestWriteCypherHandler(t *testing.T) {
 
 	t.Run("empty query parameter", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
-		// The handler should NOT call ExecuteWriteQuery when query is empty
+		// The handler should NOT call ExecuteWriteQueryWithSummary when query is empty
 		// No expectations set for mockDB since it shouldn't be called
 
 		deps := &tools.ToolDependencies{
@@ -156,7 +160,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -182,7 +186,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -207,7 +211,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: nil,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		result, err := handler(context.Background(), mcp.CallToolRequest{})
 
 		if err != nil {
@@ -221,15 +225,15 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("database query execution failure", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "INVALID CYPHER", gomock.Nil()).
-			Return(nil, errors.New("syntax error"))
+			ExecuteWriteQueryWithSummary(gomock.Any(), "INVALID CYPHER", gomock.Nil()).
+			Return(nil, nil, errors.New("syntax error"))
 
 		deps := &tools.ToolDependencies{
 			DBService:        mockDB,
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -251,8 +255,8 @@ func TestWriteCypherHandler(t *testing.T) {
 	t.Run("JSON formatting failure", func(t *testing.T) {
 		mockDB := db.NewMockService(ctrl)
 		mockDB.EXPECT().
-			ExecuteWriteQuery(gomock.Any(), "MATCH (n) RETURN n", gomock.Nil()).
-			Return([]*neo4j.Record{}, nil)
+			ExecuteWriteQueryWithSummary(gomock.Any(), "MATCH (n) RETURN n", gomock.Nil()).
+			Return([]*neo4j.Record{}, nil, nil)
 		mockDB.EXPECT().
 			Neo4jRecordsToJSON(gomock.Any()).
 			Return("", errors.New("JSON marshaling failed"))
@@ -262,7 +266,7 @@ func TestWriteCypherHandler(t *testing.T) {
 			AnalyticsService: analyticsService,
 		}
 
-		handler := cypher.WriteCypherHandler(deps)
+		handler := cypher.WriteCypherHandler(deps, nil)
 		request := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Arguments: map[string]any{
@@ -280,4 +284,308 @@ func TestWriteCypherHandler(t *testing.T) {
 			t.Error("Expected error result for JSON formatting failure")
 		}
 	})
+
+	t.Run("structured content reports the updates", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExecuteWriteQueryWithSummary(gomock.Any(), "CREATE (n:Person {name: 'Alice'})", gomock.Nil()).
+			Return([]*neo4j.Record{}, &fakeSummary{
+				queryType: neo4j.QueryTypeWriteOnly,
+				counters:  &fakeCounters{nodesCreated: 1, propertiesSet: 1, labelsAdded: 1},
+			}, nil)
+		mockDB.EXPECT().
+			Neo4jRecordsToJSON(gomock.Any()).
+			Return(`[]`, nil)
+
+		deps := &tools.ToolDependencies{
+			DBService:        mockDB,
+			AnalyticsService: analyticsService,
+		}
+
+		handler := cypher.WriteCypherHandler(deps, nil)
+		request := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]any{
+					"query": "CREATE (n:Person {name: 'Alice'})",
+				},
+			},
+		}
+
+		result, err := handler(context.Background(), request)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result == nil || result.IsError {
+			t.Fatalf("Expected success result, got: %v", result)
+		}
+		output, ok := result.StructuredContent.(*tools.QueryResult)
+		if !ok {
+			t.Fatalf("Expected a query result, got %T", result.StructuredContent)
+		}
+		if output.RowCount != 0 || len(output.Rows) != 0 || output.Truncated {
+			t.Errorf("Expected no rows, got %+v", output)
+		}
+		expected := map[string]int{"nodesCreated": 1, "propertiesSet": 1, "labelsAdded": 1}
+		if output.Summary == nil || output.Summary.QueryType != "WRITE_ONLY" || !maps.Equal(output.Summary.Counters, expected) {
+			t.Errorf("Expected a WRITE_ONLY summary with %v, got %+v", expected, output.Summary)
+		}
+	})
+}
+
+// elicitingSession is an initialized client session answering elicitation requests with a canned result.
+type elicitingSession struct {
+	capabilities mcp.ClientCapabilities
+	result       *mcp.ElicitationResult
+	requests     []mcp.ElicitationRequest
+}
+
+func (s *elicitingSession) Initialize()                                         {}
+func (s *elicitingSession) Initialized() bool                                   { return true }
+func (s *elicitingSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s *elicitingSession) SessionID() string                                   { return "write-confirmation-test" }
+func (s *elicitingSession) GetClientInfo() mcp.Implementation                   { return mcp.Implementation{} }
+func (s *elicitingSession) SetClientInfo(mcp.Implementation)                    {}
+func (s *elicitingSession) GetClientCapabilities() mcp.ClientCapabilities       { return s.capabilities }
+func (s *elicitingSession) SetClientCapabilities(mcp.ClientCapabilities)        {}
+
+func (s *elicitingSession) RequestElicitation(_ context.Context, request mcp.ElicitationRequest) (*mcp.ElicitationResult, error) {
+	s.requests = append(s.requests, request)
+	return s.result, nil
+}
+
+// callWriteCypher calls write-cypher through an MCP server, so the handler sees the session of the client.
+func callWriteCypher(t *testing.T, deps *tools.ToolDependencies, session *elicitingSession, query string) *mcp.CallToolResult {
+	t.Helper()
+	srv := server.NewMCPServer("test", "0.0.0")
+	srv.AddTool(cypher.WriteCypherSpec(), cypher.WriteCypherHandler(deps, nil))
+
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": "write-cypher", "arguments": map[string]any{"query": query}},
+	})
+	if err != nil {
+		t.Fatalf("failed to build the request: %v", err)
+	}
+	response, ok := srv.HandleMessage(srv.WithContext(context.Background(), session), message).(mcp.JSONRPCResponse)
+	if !ok {
+		t.Fatal("Expected a JSON-RPC response")
+	}
+	result, ok := response.Result.(*mcp.CallToolResult)
+	if !ok {
+		t.Fatalf("Expected a tool result, got %T", response.Result)
+	}
+	return result
+}
+
+func TestWriteCypherHandler_Confirmation(t *testing.T) {
+	const deleteQuery = "MATCH (n) DETACH DELETE n"
+	elicitationClient := mcp.ClientCapabilities{Elicitation: &mcp.ElicitationCapability{}}
+	accepted := &mcp.ElicitationResult{ElicitationResponse: mcp.ElicitationResponse{
+		Action:  mcp.ElicitationResponseActionAccept,
+		Content: map[string]any{"confirm": true},
+	}}
+
+	expectDryRunCounters := func(mockDB *db.MockService, plan neo4j.Plan, counters *fakeCounters) {
+		mockDB.EXPECT().
+			ExplainQuery(gomock.Any(), deleteQuery, gomock.Any()).
+			Return(&fakeSummary{queryType: neo4j.QueryTypeWriteOnly, plan: plan}, nil)
+		mockDB.EXPECT().
+			DryRunWriteQuery(gomock.Any(), deleteQuery, gomock.Any()).
+			Return(&fakeSummary{counters: counters}, nil)
+	}
+	expectDryRun := func(mockDB *db.MockService, nodesDeleted int) {
+		expectDryRunCounters(mockDB, &fakePlan{operator: "DetachDelete@neo4j"}, &fakeCounters{nodesDeleted: nodesDeleted})
+	}
+	expectWrite := func(mockDB *db.MockService) {
+		mockDB.EXPECT().
+			ExecuteWriteQueryWithSummary(gomock.Any(), deleteQuery, gomock.Any()).
+			Return([]*neo4j.Record{}, nil, nil)
+		mockDB.EXPECT().Neo4jRecordsToJSON(gomock.Any()).Return(`[]`, nil)
+	}
+
+	t.Run("runs the query once the user confirms it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDB := db.NewMockService(ctrl)
+		expectDryRun(mockDB, 500)
+		expectWrite(mockDB)
+		session := &elicitingSession{capabilities: elicitationClient, result: accepted}
+
+		deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100}
+		result := callWriteCypher(t, deps, session, deleteQuery)
+
+		if result.IsError {
+			t.Fatalf("Expected success result, got: %v", result.Content)
+		}
+		if len(session.requests) != 1 {
+			t.Fatalf("Expected 1 elicitation request, got %d", len(session.requests))
+		}
+		message := session.requests[0].Params.Message
+		if !strings.Contains(message, deleteQuery) || !strings.Contains(message, "nodesDeleted=500") {
+			t.Errorf("Expected the query and its impact in the elicitation message, got %q", message)
+		}
+	})
+
+	t.Run("does not run the query when the user declines it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDB := db.NewMockService(ctrl)
+		expectDryRun(mockDB, 500)
+		session := &elicitingSession{
+			capabilities: elicitationClient,
+			result:       &mcp.ElicitationResult{ElicitationResponse: mcp.ElicitationResponse{Action: mcp.ElicitationResponseActionDecline}},
+		}
+
+		deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100}
+		result := callWriteCypher(t, deps, session, deleteQuery)
+
+		if !result.IsError {
+			t.Error("Expected an error result when the user declines the query")
+		}
+	})
+
+	t.Run("does not ask below the threshold", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDB := db.NewMockService(ctrl)
+		expectDryRun(mockDB, 100)
+		expectWrite(mockDB)
+		session := &elicitingSession{capabilities: elicitationClient, result: accepted}
+
+		deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100}
+		result := callWriteCypher(t, deps, session, deleteQuery)
+
+		if result.IsError {
+			t.Fatalf("Expected success result, got: %v", result.Content)
+		}
+		if len(session.requests) != 0 {
+			t.Errorf("Expected no elicitation request, got %d", len(session.requests))
+		}
+	})
+
+	t.Run("does not count the properties and labels the created entities account for", func(t *testing.T) {
+		createPlan := func(details string) neo4j.Plan {
+			return &fakePlan{operator: "ProduceResults@neo4j", children: []neo4j.Plan{
+				&fakePlan{operator: "Create@neo4j", arguments: map[string]any{"Details": details}},
+			}}
+		}
+		setPlan := &fakePlan{operator: "SetProperty@neo4j", arguments: map[string]any{"Details": "n.flag = true"}}
+		for _, tt := range []struct {
+			name     string
+			plan     neo4j.Plan
+			counters *fakeCounters
+			asks     bool
+		}{
+			{name: "created nodes", plan: createPlan("(anon_0:Person:Actor {name: i, born: 1970})"),
+				counters: &fakeCounters{nodesCreated: 500, propertiesSet: 1000, labelsAdded: 1000}, asks: false},
+			{name: "created relationships", plan: createPlan("(a)-[anon_0:KNOWS {since: 'a, b: c'}]->(b)"),
+				counters: &fakeCounters{relsCreated: 500, propertiesSet: 500}, asks: false},
+			{name: "modified nodes", plan: setPlan, counters: &fakeCounters{propertiesSet: 500}, asks: true},
+			// MATCH (n) SET n.flag = true WITH count(*) AS c CREATE (:Log)
+			{name: "modified nodes and a created node", plan: createPlan("(anon_0:Log)"),
+				counters: &fakeCounters{nodesCreated: 1, propertiesSet: 500, labelsAdded: 1}, asks: true},
+			{name: "created nodes with a map parameter", plan: createPlan("(n $props)"),
+				counters: &fakeCounters{nodesCreated: 100, propertiesSet: 500}, asks: true},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				mockDB := db.NewMockService(ctrl)
+				expectDryRunCounters(mockDB, tt.plan, tt.counters)
+				expectWrite(mockDB)
+				session := &elicitingSession{capabilities: elicitationClient, result: accepted}
+
+				deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100}
+				if result := callWriteCypher(t, deps, session, deleteQuery); result.IsError {
+					t.Fatalf("Expected success result, got: %v", result.Content)
+				}
+				if asked := len(session.requests) > 0; asked != tt.asks {
+					t.Errorf("Expected the confirmation to be asked: %v, got %v", tt.asks, asked)
+				}
+			})
+		}
+	})
+
+	t.Run("asks without a dry run when the query calls procedures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().
+			ExplainQuery(gomock.Any(), deleteQuery, gomock.Any()).
+			Return(&fakeSummary{queryType: neo4j.QueryTypeReadWrite, plan: &fakePlan{
+				operator: "ProduceResults@neo4j",
+				children: []neo4j.Plan{&fakePlan{operator: "ProcedureCall@neo4j"}},
+			}}, nil)
+		expectWrite(mockDB)
+		session := &elicitingSession{capabilities: elicitationClient, result: accepted}
+
+		deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100}
+		result := callWriteCypher(t, deps, session, deleteQuery)
+
+		if result.IsError {
+			t.Fatalf("Expected success result, got: %v", result.Content)
+		}
+		if len(session.requests) != 1 || !strings.Contains(session.requests[0].Params.Message, "ProcedureCall") {
+			t.Errorf("Expected the user to be asked about the procedure call, got %+v", session.requests)
+		}
+	})
+
+	t.Run("runs the schema commands adding indexes and constraints without asking", func(t *testing.T) {
+		for _, tt := range []struct {
+			operator string
+			asks     bool
+		}{
+			{operator: "CreateIndex@neo4j", asks: false},
+			{operator: "CreateConstraint@neo4j", asks: false},
+			{operator: "DoNothingIfExists(INDEX)@neo4j", asks: false},
+			{operator: "DropIndex@neo4j", asks: true},
+			{operator: "DropConstraint@neo4j", asks: true},
+		} {
+			t.Run(tt.operator, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				mockDB := db.NewMockService(ctrl)
+				mockDB.EXPECT().
+					ExplainQuery(gomock.Any(), deleteQuery, gomock.Any()).
+					Return(&fakeSummary{queryType: neo4j.QueryTypeSchemaWrite, plan: &fakePlan{operator: tt.operator}}, nil)
+				if !tt.asks {
+					expectWrite(mockDB)
+				}
+
+				deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100, DenyUnconfirmedWrites: true}
+				result := callWriteCypher(t, deps, &elicitingSession{}, deleteQuery)
+
+				if result.IsError != tt.asks {
+					t.Errorf("Expected the query to be denied: %v, got %v", tt.asks, result.IsError)
+				}
+			})
+		}
+	})
+
+	t.Run("denies the query when the client cannot confirm it and the fallback denies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDB := db.NewMockService(ctrl)
+		expectDryRun(mockDB, 500)
+		session := &elicitingSession{}
+
+		deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100, DenyUnconfirmedWrites: true}
+		result := callWriteCypher(t, deps, session, deleteQuery)
+
+		if !result.IsError {
+			t.Error("Expected an error result when the query cannot be confirmed")
+		}
+		if len(session.requests) != 0 {
+			t.Errorf("Expected no elicitation request to a client without elicitation, got %d", len(session.requests))
+		}
+	})
+
+	t.Run("runs the query without a dry run when the client cannot confirm it and the fallback allows", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDB := db.NewMockService(ctrl)
+		expectWrite(mockDB)
+
+		deps := &tools.ToolDependencies{DBService: mockDB, WriteConfirmationThreshold: 100}
+		result := callWriteCypher(t, deps, &elicitingSession{}, deleteQuery)
+
+		if result.IsError {
+			t.Fatalf("Expected success result, got: %v", result.Content)
+		}
+	})
 }
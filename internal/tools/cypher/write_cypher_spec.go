@@ -5,17 +5,21 @@ package cypher
 
 import (
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
 )
 
 type WriteCypherInput struct {
-	Query  string `json:"query" jsonschema:"The Cypher query to execute"`
-	Params Params `json:"params,omitempty" jsonschema:"Parameters to pass to the Cypher query"`
+	Query    string `json:"query" jsonschema:"The Cypher query to execute"`
+	Params   Params `json:"params,omitempty" jsonschema:"Parameters to pass to the Cypher query"`
+	Database string `json:"database,omitempty" jsonschema:"The database to run the query against, defaults to the configured database. Use list-databases to discover the available databases"`
 }
 
 func WriteCypherSpec() mcp.Tool {
 	return mcp.NewTool("write-cypher",
-		mcp.WithDescription("write-cypher executes any arbitrary Cypher query, with write access, against the user-configured Neo4j database."),
+		mcp.WithDescription("write-cypher executes any arbitrary Cypher query, with write access, against the user-configured Neo4j database. "+
+			"Queries deleting or modifying many existing entities may require the confirmation of the user before they run."),
 		mcp.WithInputSchema[WriteCypherInput](),
+		mcp.WithOutputSchema[tools.QueryResult](),
 		mcp.WithTitleAnnotation("Write Cypher"),
 		mcp.WithReadOnlyHintAnnotation(false),
 		mcp.WithDestructiveHintAnnotation(true),
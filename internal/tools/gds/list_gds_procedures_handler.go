@@ -39,11 +39,12 @@ func handleListGdsProcedures(ctx context.Context, deps *tools.ToolDependencies)
 		return mcp.NewToolResultError(formattedErrorMessage.Error()), nil
 	}
 
-	response, err := deps.DBService.Neo4jRecordsToJSON(records)
+	result, records := deps.NewQueryResult(records)
+	response, err := deps.FormatRecords(ctx, records)
 	if err != nil {
 		slog.Error("failed to format list-gds-procedures results to JSON", "error", err)
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return mcp.NewToolResultStructured(result, response), nil
 }
@@ -3,7 +3,10 @@
 
 package gds
 
-import "github.com/mark3labs/mcp-go/mcp"
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools"
+)
 
 func ListGDSProceduresSpec() mcp.Tool {
 	return mcp.NewTool("list-gds-procedures",
@@ -17,6 +20,7 @@ func ListGDSProceduresSpec() mcp.Tool {
 				"Remember to use unique names for graph data science projections to avoid collisions and to drop them afterwards to save memory. "+
 				"You must always tell the user the function you will use.",
 		),
+		mcp.WithOutputSchema[tools.QueryResult](),
 		mcp.WithTitleAnnotation("List available Neo4j GDS procedures"),
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithIdempotentHintAnnotation(true),
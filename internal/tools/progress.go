@@ -0,0 +1,208 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+const (
+	// progressInterval is the minimum time between two progress notifications of a tool call.
+	progressInterval = time.Second
+	// gdsProgressPollInterval is how often gds.listProgress is polled while a query runs.
+	gdsProgressPollInterval = 2 * time.Second
+
+	// gdsListProgressQuery lists the running jobs of the user, one row per job
+	gdsListProgressQuery = `
+        CALL gds.listProgress()
+        YIELD jobId, taskName, progress, status
+        WHERE status = "RUNNING"
+        RETURN jobId, taskName, progress
+    `
+)
+
+// ProgressReporter sends notifications/progress for a tool call when the request carries a progress token.
+// The notifications go to the session of the call, whatever the transport.
+// A nil reporter is valid and reports nothing, so handlers do not need to check for a token.
+type ProgressReporter struct {
+	ctx   context.Context
+	srv   *server.MCPServer
+	token mcp.ProgressToken
+
+	mu         sync.Mutex
+	progress   float64
+	lastReport time.Time
+}
+
+// NewProgressReporter returns the reporter of a tool call, nil when the client did not ask for progress.
+func NewProgressReporter(ctx context.Context, request mcp.CallToolRequest) *ProgressReporter {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return nil
+	}
+	srv := server.ServerFromContext(ctx)
+	if srv == nil {
+		return nil
+	}
+	return &ProgressReporter{ctx: ctx, srv: srv, token: request.Params.Meta.ProgressToken}
+}
+
+// Report sends a progress notification, total is 0 when unknown.
+// The progress must increase with every notification, lower or equal values are dropped,
+// and notifications are sent at most once per progressInterval.
+func (r *ProgressReporter) Report(progress, total float64, message string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	if progress <= r.progress || time.Since(r.lastReport) < progressInterval {
+		r.mu.Unlock()
+		return
+	}
+	r.progress = progress
+	r.lastReport = time.Now()
+	r.mu.Unlock()
+
+	params := map[string]any{
+		"progressToken": r.token,
+		"progress":      progress,
+	}
+	if total > 0 {
+		params["total"] = total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	if err := r.srv.SendNotificationToClient(r.ctx, string(mcp.MethodNotificationProgress), params); err != nil {
+		slog.Debug("failed to send progress notification", "error", err)
+	}
+}
+
+// WithRecordProgress returns a context in which the database service reports the records received so far.
+func (r *ProgressReporter) WithRecordProgress(ctx context.Context) context.Context {
+	if r == nil {
+		return ctx
+	}
+	return database.WithRecordProgress(ctx, func(records int) {
+		r.Report(float64(records), 0, fmt.Sprintf("%d records received", records))
+	})
+}
+
+// gdsJob identifies the GDS job of a tool call among the running jobs of the user.
+type gdsJob struct {
+	id string
+	// ignored are the jobs running before the call started, used while id is unknown
+	ignored map[string]bool
+}
+
+// TrackGDSJob polls gds.listProgress until the returned function is called, and reports the progress
+// of the GDS job started with the jobId configuration parameter set to jobID.
+func (r *ProgressReporter) TrackGDSJob(ctx context.Context, dbService database.Service, jobID string) (stop func()) {
+	if r == nil {
+		return func() {}
+	}
+	return r.trackGDS(ctx, dbService, &gdsJob{id: jobID})
+}
+
+// TrackGDSProgress polls gds.listProgress until the returned function is called, and reports the progress
+// of the GDS job started by the call, when its id is unknown. gds.listProgress lists every job of the user:
+// the job of the call is the only one started since TrackGDSProgress was called. Nothing is reported while
+// concurrent calls of the user started jobs too, as they cannot be told apart.
+func (r *ProgressReporter) TrackGDSProgress(ctx context.Context, dbService database.Service) (stop func()) {
+	if r == nil {
+		return func() {}
+	}
+	job := &gdsJob{ignored: make(map[string]bool)}
+	records, err := dbService.ExecuteReadQuery(ctx, gdsListProgressQuery, nil)
+	if err != nil {
+		slog.Debug("failed to list the GDS progress", "error", err)
+	}
+	for _, record := range records {
+		jobID, _, _ := neo4j.GetRecordValue[string](record, "jobId")
+		job.ignored[jobID] = true
+	}
+	return r.trackGDS(ctx, dbService, job)
+}
+
+func (r *ProgressReporter) trackGDS(ctx context.Context, dbService database.Service, job *gdsJob) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(gdsProgressPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.reportGDSProgress(ctx, dbService, job)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// reportGDSProgress reports the progress of the job, as a percentage.
+func (r *ProgressReporter) reportGDSProgress(ctx context.Context, dbService database.Service, job *gdsJob) {
+	records, err := dbService.ExecuteReadQuery(ctx, gdsListProgressQuery, nil)
+	if err != nil {
+		slog.Debug("failed to list the GDS progress", "error", err)
+		return
+	}
+	if job.id == "" {
+		var started []string
+		for _, record := range records {
+			jobID, _, _ := neo4j.GetRecordValue[string](record, "jobId")
+			if !job.ignored[jobID] && !slices.Contains(started, jobID) {
+				started = append(started, jobID)
+			}
+		}
+		if len(started) != 1 {
+			return
+		}
+		job.id = started[0]
+	}
+	for _, record := range records {
+		jobID, _, _ := neo4j.GetRecordValue[string](record, "jobId")
+		if jobID != job.id {
+			continue
+		}
+		taskName, _, _ := neo4j.GetRecordValue[string](record, "taskName")
+		progress, _, _ := neo4j.GetRecordValue[string](record, "progress")
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(progress, "%"), 64)
+		if err != nil {
+			return
+		}
+		r.Report(percent, 100, fmt.Sprintf("%s: %.1f%%", taskName, percent))
+		return
+	}
+}
+
+// TrackQuery returns the context to run a Cypher query with, in which the records received are reported.
+// When GDS is installed and the query calls it, the GDS progress is reported instead until the returned function
+// is called: a percentage and a record count cannot share the progress of a call, which must only increase.
+func (r *ProgressReporter) TrackQuery(ctx context.Context, deps *ToolDependencies, query string) (context.Context, func()) {
+	if r == nil {
+		return ctx, func() {}
+	}
+	if deps.GDSInstalled && strings.Contains(strings.ToLower(query), "gds.") {
+		return ctx, r.TrackGDSProgress(ctx, deps.DBService)
+	}
+	return r.WithRecordProgress(ctx), func() {}
+}
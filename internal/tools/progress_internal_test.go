@@ -0,0 +1,139 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	db "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// progressSession is an initialized client session collecting its notifications.
+type progressSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *progressSession) Initialize()       {}
+func (s *progressSession) Initialized() bool { return true }
+func (s *progressSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+func (s *progressSession) SessionID() string { return "gds-progress-test" }
+
+// newTestReporter returns a reporter sending its notifications to the returned session.
+func newTestReporter(t *testing.T) (*ProgressReporter, *progressSession) {
+	t.Helper()
+	srv := server.NewMCPServer("test", "0.0.0")
+	session := &progressSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	if err := srv.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("failed to register the session: %v", err)
+	}
+	return &ProgressReporter{ctx: srv.WithContext(context.Background(), session), srv: srv, token: "token"}, session
+}
+
+func gdsJobs(jobs ...[3]string) []*neo4j.Record {
+	records := make([]*neo4j.Record, 0, len(jobs))
+	for _, job := range jobs {
+		records = append(records, &neo4j.Record{Keys: []string{"jobId", "taskName", "progress"}, Values: []any{job[0], job[1], job[2]}})
+	}
+	return records
+}
+
+func progressMessages(session *progressSession) []any {
+	var messages []any
+	for {
+		select {
+		case notification := <-session.notifications:
+			messages = append(messages, notification.Params.AdditionalFields["message"])
+		default:
+			return messages
+		}
+	}
+}
+
+func TestReportGDSProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("reports the job started by the call", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gdsListProgressQuery, gomock.Nil()).
+			Return(gdsJobs([3]string{"other", "PageRank", "90%"}, [3]string{"mine", "Dijkstra", "25%"}), nil)
+		reporter, session := newTestReporter(t)
+
+		job := &gdsJob{ignored: map[string]bool{"other": true}}
+		reporter.reportGDSProgress(context.Background(), mockDB, job)
+
+		if job.id != "mine" {
+			t.Errorf("expected the job started by the call to be tracked, got %q", job.id)
+		}
+		if messages := progressMessages(session); len(messages) != 1 || messages[0] != "Dijkstra: 25.0%" {
+			t.Errorf("expected the progress of the job started by the call, got %v", messages)
+		}
+	})
+
+	t.Run("reports nothing while the job cannot be told from concurrent ones", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gdsListProgressQuery, gomock.Nil()).
+			Return(gdsJobs([3]string{"first", "PageRank", "90%"}, [3]string{"second", "Dijkstra", "25%"}), nil)
+		reporter, session := newTestReporter(t)
+
+		job := &gdsJob{ignored: map[string]bool{}}
+		reporter.reportGDSProgress(context.Background(), mockDB, job)
+
+		if job.id != "" {
+			t.Errorf("expected no job to be tracked, got %q", job.id)
+		}
+		if messages := progressMessages(session); len(messages) != 0 {
+			t.Errorf("expected no progress, got %v", messages)
+		}
+	})
+
+	t.Run("reports the job with the given id", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gdsListProgressQuery, gomock.Nil()).
+			Return(gdsJobs([3]string{"other", "PageRank", "10%"}, [3]string{"neo4j-mcp-paths-1", "Dijkstra", "60%"}), nil)
+		reporter, session := newTestReporter(t)
+
+		reporter.reportGDSProgress(context.Background(), mockDB, &gdsJob{id: "neo4j-mcp-paths-1"})
+
+		if messages := progressMessages(session); len(messages) != 1 || messages[0] != "Dijkstra: 60.0%" {
+			t.Errorf("expected the progress of the given job, got %v", messages)
+		}
+	})
+}
+
+func TestTrackQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("reports the records of a Cypher query", func(t *testing.T) {
+		reporter, _ := newTestReporter(t)
+		ctx := context.Background()
+		trackedCtx, stop := reporter.TrackQuery(ctx, &ToolDependencies{}, "MATCH (n) RETURN n")
+		defer stop()
+
+		if trackedCtx == ctx {
+			t.Error("expected the records to be reported")
+		}
+	})
+
+	t.Run("reports only the GDS progress of a query calling GDS", func(t *testing.T) {
+		mockDB := db.NewMockService(ctrl)
+		mockDB.EXPECT().ExecuteReadQuery(gomock.Any(), gdsListProgressQuery, gomock.Nil()).Return(gdsJobs(), nil).AnyTimes()
+		reporter, _ := newTestReporter(t)
+		ctx := context.Background()
+		trackedCtx, stop := reporter.TrackQuery(ctx, &ToolDependencies{DBService: mockDB, GDSInstalled: true}, "CALL gds.pageRank.stream('g')")
+		defer stop()
+
+		if trackedCtx != ctx {
+			t.Error("expected the records not to be reported with the GDS progress")
+		}
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// fakeSession is an initialized client session collecting its notifications.
+type fakeSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *fakeSession) Initialize()                                         {}
+func (s *fakeSession) Initialized() bool                                   { return true }
+func (s *fakeSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return s.notifications }
+func (s *fakeSession) SessionID() string                                   { return "progress-test" }
+
+// callWithProgress calls a tool running report on a server, with the given request metadata,
+// and returns the notifications sent to the session.
+func callWithProgress(t *testing.T, meta string, report func(*tools.ProgressReporter)) []mcp.JSONRPCNotification {
+	t.Helper()
+	srv := server.NewMCPServer("test", "0.0.0")
+	srv.AddTool(mcp.NewTool("long-running"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		report(tools.NewProgressReporter(ctx, request))
+		return mcp.NewToolResultText("done"), nil
+	})
+	session := &fakeSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	if err := srv.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("failed to register the session: %v", err)
+	}
+
+	message := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"long-running"` + meta + `}}`
+	srv.HandleMessage(srv.WithContext(context.Background(), session), json.RawMessage(message))
+
+	var notifications []mcp.JSONRPCNotification
+	for {
+		select {
+		case notification := <-session.notifications:
+			notifications = append(notifications, notification)
+		default:
+			return notifications
+		}
+	}
+}
+
+func TestProgressReporter(t *testing.T) {
+	t.Run("sends progress notifications with the request token", func(t *testing.T) {
+		notifications := callWithProgress(t, `,"_meta":{"progressToken":"token-1"}`, func(progress *tools.ProgressReporter) {
+			if progress == nil {
+				t.Fatal("expected a progress reporter")
+			}
+			progress.Report(40, 100, "running")
+		})
+
+		if len(notifications) != 1 {
+			t.Fatalf("expected 1 notification, got %d", len(notifications))
+		}
+		notification := notifications[0]
+		if notification.Method != string(mcp.MethodNotificationProgress) {
+			t.Errorf("expected method %s, got %s", mcp.MethodNotificationProgress, notification.Method)
+		}
+		fields := notification.Params.AdditionalFields
+		if fields["progressToken"] != "token-1" || fields["progress"] != 40.0 || fields["total"] != 100.0 || fields["message"] != "running" {
+			t.Errorf("unexpected notification params: %v", fields)
+		}
+	})
+
+	t.Run("drops lower and throttled progress", func(t *testing.T) {
+		notifications := callWithProgress(t, `,"_meta":{"progressToken":7}`, func(progress *tools.ProgressReporter) {
+			progress.Report(10, 0, "")
+			progress.Report(5, 0, "")
+			progress.Report(20, 0, "")
+		})
+
+		if len(notifications) != 1 {
+			t.Fatalf("expected 1 notification, got %d", len(notifications))
+		}
+		fields := notifications[0].Params.AdditionalFields
+		if fields["progress"] != 10.0 {
+			t.Errorf("expected progress 10, got %v", fields["progress"])
+		}
+		if _, ok := fields["total"]; ok {
+			t.Errorf("expected no total when unknown, got %v", fields["total"])
+		}
+	})
+
+	t.Run("reports nothing without a progress token", func(t *testing.T) {
+		notifications := callWithProgress(t, "", func(progress *tools.ProgressReporter) {
+			if progress != nil {
+				t.Error("expected no progress reporter without a progress token")
+			}
+			// A nil reporter is safe to use
+			progress.Report(1, 0, "")
+			ctx, stop := progress.TrackQuery(context.Background(), &tools.ToolDependencies{GDSInstalled: true}, "CALL gds.pageRank.stream('g')")
+			stop()
+			if ctx == nil {
+				t.Error("expected the context to be returned")
+			}
+		})
+
+		if len(notifications) != 0 {
+			t.Errorf("expected no notification, got %d", len(notifications))
+		}
+	})
+}
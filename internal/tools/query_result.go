@@ -0,0 +1,105 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+
+	"github.com/neo4j/mcp/internal/session"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+)
+
+// QueryResult is the structured output of the tools returning the records of a Cypher query.
+type QueryResult struct {
+	Columns   []string         `json:"columns" jsonschema:"The columns returned by the query"`
+	Rows      []map[string]any `json:"rows" jsonschema:"The returned rows, keyed by column"`
+	RowCount  int              `json:"rowCount" jsonschema:"The number of rows produced by the query, including the rows left out when truncated"`
+	Truncated bool             `json:"truncated" jsonschema:"True when only the first rows are returned, see NEO4J_MCP_MAX_RESULT_ROWS"`
+	Summary   *QuerySummary    `json:"summary,omitempty" jsonschema:"What the query did, when known"`
+}
+
+// QuerySummary is the part of the Neo4j result summary returned with a QueryResult.
+type QuerySummary struct {
+	QueryType string         `json:"queryType" jsonschema:"READ_ONLY, READ_WRITE, WRITE_ONLY or SCHEMA_WRITE"`
+	Counters  map[string]int `json:"counters,omitempty" jsonschema:"The non-zero update counters, e.g. nodesCreated or propertiesSet"`
+}
+
+// NewQueryResult builds the structured output of the records, keeping the first MaxResultRows rows when set.
+// The kept records are returned too, so the text content holds the same rows.
+func (d *ToolDependencies) NewQueryResult(records []*neo4j.Record) (*QueryResult, []*neo4j.Record) {
+	result := &QueryResult{
+		Columns:  []string{},
+		Rows:     make([]map[string]any, 0, len(records)),
+		RowCount: len(records),
+	}
+	if d.MaxResultRows > 0 && len(records) > d.MaxResultRows {
+		records = records[:d.MaxResultRows]
+		result.Truncated = true
+	}
+	if len(records) > 0 {
+		result.Columns = records[0].Keys
+	}
+	for _, record := range records {
+		result.Rows = append(result.Rows, record.AsMap())
+	}
+	return result, records
+}
+
+// compactRecords is the compact result format, the columns are listed once and the rows are arrays of values.
+type compactRecords struct {
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+}
+
+// FormatRecords formats the records for the text content of the query tools, in the result format
+// of the session of the request (see session.Settings), as indented JSON objects keyed by column otherwise.
+func (d *ToolDependencies) FormatRecords(ctx context.Context, records []*neo4j.Record) (string, error) {
+	if s, ok := session.FromContext(ctx); !ok || s.Settings().ResultFormat != session.ResultFormatCompact {
+		return d.DBService.Neo4jRecordsToJSON(records)
+	}
+	compact := compactRecords{Columns: []string{}, Rows: make([][]any, 0, len(records))}
+	if len(records) > 0 {
+		compact.Columns = records[0].Keys
+	}
+	for _, record := range records {
+		compact.Rows = append(compact.Rows, record.Values)
+	}
+	formatted, err := json.Marshal(compact)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to format records as compact JSON: %w", err)
+		slog.Error("Error in FormatRecords", "error", wrappedErr)
+		return "", wrappedErr
+	}
+	return string(formatted), nil
+}
+
+// NewQueryCounters returns the non-zero counters of a query, nil when nothing was updated.
+func NewQueryCounters(counters neo4j.Counters) map[string]int {
+	if counters == nil {
+		return nil
+	}
+	all := map[string]int{
+		"nodesCreated":         counters.NodesCreated(),
+		"nodesDeleted":         counters.NodesDeleted(),
+		"relationshipsCreated": counters.RelationshipsCreated(),
+		"relationshipsDeleted": counters.RelationshipsDeleted(),
+		"propertiesSet":        counters.PropertiesSet(),
+		"labelsAdded":          counters.LabelsAdded(),
+		"labelsRemoved":        counters.LabelsRemoved(),
+		"indexesAdded":         counters.IndexesAdded(),
+		"indexesRemoved":       counters.IndexesRemoved(),
+		"constraintsAdded":     counters.ConstraintsAdded(),
+		"constraintsRemoved":   counters.ConstraintsRemoved(),
+		"systemUpdates":        counters.SystemUpdates(),
+	}
+	maps.DeleteFunc(all, func(_ string, value int) bool { return value == 0 })
+	if len(all) == 0 {
+		return nil
+	}
+	return all
+}
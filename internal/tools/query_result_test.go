@@ -0,0 +1,126 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package tools_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	database_mocks "github.com/neo4j/mcp/internal/database/mocks"
+	"github.com/neo4j/mcp/internal/session"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/neo4j-go-driver/v6/neo4j"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeCounters reports a created node with its properties, calling any other method panics.
+type fakeCounters struct {
+	neo4j.Counters
+}
+
+func (fakeCounters) NodesCreated() int         { return 1 }
+func (fakeCounters) NodesDeleted() int         { return 0 }
+func (fakeCounters) RelationshipsCreated() int { return 0 }
+func (fakeCounters) RelationshipsDeleted() int { return 0 }
+func (fakeCounters) PropertiesSet() int        { return 2 }
+func (fakeCounters) LabelsAdded() int          { return 1 }
+func (fakeCounters) LabelsRemoved() int        { return 0 }
+func (fakeCounters) IndexesAdded() int         { return 0 }
+func (fakeCounters) IndexesRemoved() int       { return 0 }
+func (fakeCounters) ConstraintsAdded() int     { return 0 }
+func (fakeCounters) ConstraintsRemoved() int   { return 0 }
+func (fakeCounters) SystemUpdates() int        { return 0 }
+
+func TestToolDependencies_NewQueryResult(t *testing.T) {
+	records := []*neo4j.Record{
+		{Keys: []string{"name", "age"}, Values: []any{"Alice", int64(30)}},
+		{Keys: []string{"name", "age"}, Values: []any{"Bob", int64(40)}},
+		{Keys: []string{"name", "age"}, Values: []any{"Carol", int64(50)}},
+	}
+
+	t.Run("no limit", func(t *testing.T) {
+		result, kept := (&tools.ToolDependencies{}).NewQueryResult(records)
+		if len(kept) != 3 || result.RowCount != 3 || len(result.Rows) != 3 || result.Truncated {
+			t.Errorf("Expected every row, got %+v", result)
+		}
+		if !slices.Equal(result.Columns, []string{"name", "age"}) {
+			t.Errorf("Expected the name and age columns, got %v", result.Columns)
+		}
+		if result.Rows[2]["name"] != "Carol" || result.Rows[2]["age"] != int64(50) {
+			t.Errorf("Expected the rows keyed by column, got %v", result.Rows[2])
+		}
+	})
+
+	t.Run("truncated to the first rows", func(t *testing.T) {
+		result, kept := (&tools.ToolDependencies{MaxResultRows: 2}).NewQueryResult(records)
+		if len(kept) != 2 || len(result.Rows) != 2 || result.Rows[1]["name"] != "Bob" {
+			t.Errorf("Expected the first two rows, got %+v", result.Rows)
+		}
+		if result.RowCount != 3 || !result.Truncated {
+			t.Errorf("Expected 3 rows truncated, got rowCount %d truncated %v", result.RowCount, result.Truncated)
+		}
+	})
+
+	t.Run("limit not reached", func(t *testing.T) {
+		result, _ := (&tools.ToolDependencies{MaxResultRows: 3}).NewQueryResult(records)
+		if result.Truncated || len(result.Rows) != 3 {
+			t.Errorf("Expected every row, got %+v", result)
+		}
+	})
+
+	t.Run("no records", func(t *testing.T) {
+		result, _ := (&tools.ToolDependencies{}).NewQueryResult(nil)
+		if result.Columns == nil || result.Rows == nil || result.RowCount != 0 {
+			t.Errorf("Expected empty columns and rows, got %+v", result)
+		}
+	})
+}
+
+func TestNewQueryCounters(t *testing.T) {
+	if counters := tools.NewQueryCounters(nil); counters != nil {
+		t.Errorf("Expected no counters, got %v", counters)
+	}
+
+	expected := map[string]int{"nodesCreated": 1, "propertiesSet": 2, "labelsAdded": 1}
+	if counters := tools.NewQueryCounters(fakeCounters{}); !maps.Equal(counters, expected) {
+		t.Errorf("Expected %v, got %v", expected, counters)
+	}
+}
+
+func TestToolDependencies_FormatRecords(t *testing.T) {
+	records := []*neo4j.Record{
+		{Keys: []string{"name", "age"}, Values: []any{"Alice", int64(30)}},
+		{Keys: []string{"name", "age"}, Values: []any{"Bob", int64(40)}},
+	}
+
+	t.Run("compact in a session selecting it", func(t *testing.T) {
+		store := session.NewStore(1, 0)
+		s, err := store.Create("basic:neo4j")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		s.UpdateSettings(func(settings *session.Settings) { settings.ResultFormat = session.ResultFormatCompact })
+
+		formatted, err := (&tools.ToolDependencies{}).FormatRecords(session.WithSession(context.Background(), s), records)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := `{"columns":["name","age"],"rows":[["Alice",30],["Bob",40]]}`
+		if formatted != expected {
+			t.Errorf("Expected %s, got %s", expected, formatted)
+		}
+	})
+
+	t.Run("JSON objects without a session", func(t *testing.T) {
+		mockDB := database_mocks.NewMockService(gomock.NewController(t))
+		mockDB.EXPECT().Neo4jRecordsToJSON(records).Return("[]", nil)
+
+		formatted, err := (&tools.ToolDependencies{DBService: mockDB}).FormatRecords(context.Background(), records)
+		if err != nil || formatted != "[]" {
+			t.Errorf("Expected the records formatted by the database service, got %q, %v", formatted, err)
+		}
+	})
+}
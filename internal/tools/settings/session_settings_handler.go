@@ -0,0 +1,92 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/session"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+// SessionSettingsHandler returns a handler function for the session-settings tool
+func SessionSettingsHandler(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleSessionSettings(ctx, request, deps)
+	}
+}
+
+func handleSessionSettings(ctx context.Context, request mcp.CallToolRequest, deps *tools.ToolDependencies) (*mcp.CallToolResult, error) {
+	s, ok := session.FromContext(ctx)
+	if !ok {
+		errMessage := "session-settings requires a stateful HTTP session, see NEO4J_MCP_HTTP_STATEFUL"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	var args SessionSettingsInput
+	if err := request.BindArguments(&args); err != nil {
+		slog.Error("error binding arguments", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if args.Database != nil {
+		if _, err := deps.WithTargetDatabase(ctx, *args.Database); err != nil {
+			slog.Error("error selecting the session database", "error", err)
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+	// session-settings is allowed in a read-only session, it must not lift the restriction
+	if args.ReadOnly != nil && !*args.ReadOnly && s.Settings().ReadOnly {
+		errMessage := "readOnly cannot be turned off in a read-only session, initialize a new session to write"
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+	resultFormat := strings.ToLower(args.ResultFormat)
+	if resultFormat != "" && !slices.Contains(session.ValidResultFormats, resultFormat) {
+		errMessage := fmt.Sprintf("invalid result format %q, must be one of %v", args.ResultFormat, session.ValidResultFormats)
+		slog.Error(errMessage)
+		return mcp.NewToolResultError(errMessage), nil
+	}
+
+	settings := s.UpdateSettings(func(settings *session.Settings) {
+		if args.Database != nil {
+			settings.Database = *args.Database
+		}
+		if resultFormat != "" {
+			settings.ResultFormat = resultFormat
+		}
+		if args.ReadOnly != nil {
+			settings.ReadOnly = *args.ReadOnly
+		}
+	})
+	if args.Bookmarks != nil {
+		s.ReplaceBookmarks(args.Bookmarks)
+	}
+	slog.Info("session settings", "database", settings.Database, "resultFormat", settings.ResultFormat, "readOnly", settings.ReadOnly)
+
+	bookmarks, err := s.Bookmarks(ctx)
+	if err != nil {
+		slog.Error("error retrieving the session bookmarks", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result := SessionSettingsResult{
+		SessionID: s.ID(),
+		Settings:  settings,
+		Bookmarks: bookmarks,
+	}
+
+	jsonData, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("failed to serialize the session settings", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultStructured(result, string(jsonData)), nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package settings_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/session"
+	"github.com/neo4j/mcp/internal/tools"
+	"github.com/neo4j/mcp/internal/tools/settings"
+)
+
+func callSessionSettings(t *testing.T, ctx context.Context, arguments map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	deps := &tools.ToolDependencies{AllowedDatabases: []string{"neo4j", "movies"}}
+	result, err := settings.SessionSettingsHandler(deps)(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{Arguments: arguments},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	return result
+}
+
+func TestSessionSettingsHandler(t *testing.T) {
+	newSessionContext := func() (context.Context, *session.Session) {
+		s, err := session.NewStore(10, 0).Create("basic:neo4j")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return session.WithSession(context.Background(), s), s
+	}
+
+	t.Run("returns the default settings", func(t *testing.T) {
+		ctx, s := newSessionContext()
+
+		result := callSessionSettings(t, ctx, nil)
+		if result.IsError {
+			t.Fatalf("Expected success result, got %v", result.Content)
+		}
+		output, ok := result.StructuredContent.(settings.SessionSettingsResult)
+		if !ok {
+			t.Fatalf("Expected a SessionSettingsResult, got %T", result.StructuredContent)
+		}
+		expected := session.Settings{ResultFormat: session.ResultFormatJSON}
+		if output.SessionID != s.ID() || output.Settings != expected || output.Bookmarks == nil || len(output.Bookmarks) != 0 {
+			t.Errorf("Unexpected settings: %+v", output)
+		}
+	})
+
+	t.Run("changes the requested settings", func(t *testing.T) {
+		ctx, s := newSessionContext()
+
+		result := callSessionSettings(t, ctx, map[string]any{
+			"database":     "movies",
+			"resultFormat": "COMPACT",
+			"readOnly":     true,
+			"bookmarks":    []any{"bookmark-2", "bookmark-1"},
+		})
+		if result.IsError {
+			t.Fatalf("Expected success result, got %v", result.Content)
+		}
+		expected := session.Settings{Database: "movies", ResultFormat: session.ResultFormatCompact, ReadOnly: true}
+		if s.Settings() != expected {
+			t.Errorf("Expected %+v, got %+v", expected, s.Settings())
+		}
+		output := result.StructuredContent.(settings.SessionSettingsResult)
+		if !slices.Equal(output.Bookmarks, []string{"bookmark-1", "bookmark-2"}) {
+			t.Errorf("Expected the replaced bookmarks, got %v", output.Bookmarks)
+		}
+
+		// The omitted settings are unchanged, an empty database selects the configured one again
+		result = callSessionSettings(t, ctx, map[string]any{"database": "", "bookmarks": []any{}})
+		if result.IsError {
+			t.Fatalf("Expected success result, got %v", result.Content)
+		}
+		expected.Database = ""
+		if s.Settings() != expected {
+			t.Errorf("Expected %+v, got %+v", expected, s.Settings())
+		}
+		if bookmarks, _ := s.Bookmarks(ctx); len(bookmarks) != 0 {
+			t.Errorf("Expected the bookmarks to be dropped, got %v", bookmarks)
+		}
+	})
+
+	t.Run("rejects a database not allowed", func(t *testing.T) {
+		ctx, s := newSessionContext()
+
+		result := callSessionSettings(t, ctx, map[string]any{"database": "secrets"})
+		if !result.IsError {
+			t.Error("Expected an error result")
+		}
+		if s.Settings().Database != "" {
+			t.Errorf("Expected the database to be unchanged, got %q", s.Settings().Database)
+		}
+	})
+
+	t.Run("rejects an unknown result format", func(t *testing.T) {
+		ctx, _ := newSessionContext()
+
+		if result := callSessionSettings(t, ctx, map[string]any{"resultFormat": "csv"}); !result.IsError {
+			t.Error("Expected an error result")
+		}
+	})
+
+	t.Run("keeps a read-only session read-only", func(t *testing.T) {
+		ctx, s := newSessionContext()
+		if result := callSessionSettings(t, ctx, map[string]any{"readOnly": true}); result.IsError {
+			t.Fatalf("Expected success result, got %v", result.Content)
+		}
+
+		if result := callSessionSettings(t, ctx, map[string]any{"readOnly": false, "database": "movies"}); !result.IsError {
+			t.Error("Expected an error result")
+		}
+		if !s.Settings().ReadOnly || s.Settings().Database != "" {
+			t.Errorf("Expected the session to stay read-only and unchanged, got %+v", s.Settings())
+		}
+		if result := callSessionSettings(t, ctx, map[string]any{"readOnly": true, "database": "movies"}); result.IsError {
+			t.Errorf("Expected the other settings to be changed, got %v", result.Content)
+		}
+	})
+
+	t.Run("requires a stateful session", func(t *testing.T) {
+		if result := callSessionSettings(t, context.Background(), nil); !result.IsError {
+			t.Error("Expected an error result")
+		}
+	})
+}
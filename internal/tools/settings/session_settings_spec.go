@@ -0,0 +1,45 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+// Package settings contains the tools reading and changing the settings of a stateful HTTP session.
+package settings
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/session"
+)
+
+// SessionSettingsInput lists the settings to change, the omitted ones are left unchanged.
+type SessionSettingsInput struct {
+	Database     *string  `json:"database,omitempty" jsonschema:"The database the tools target when a call names none, an empty string for the configured database. Use list-databases to discover the available databases"`
+	ResultFormat string   `json:"resultFormat,omitempty" jsonschema:"The format of the records in the text content of the query tools: json (objects keyed by column) or compact (the columns once and the rows as arrays)"`
+	ReadOnly     *bool    `json:"readOnly,omitempty" jsonschema:"True to refuse the tools modifying the database in this session. A read-only session cannot be switched back, initialize a new session to write"`
+	Bookmarks    []string `json:"bookmarks,omitempty" jsonschema:"Replaces the causal bookmarks of the session, e.g. with the bookmarks returned in another session to read its changes. An empty list drops them"`
+}
+
+// SessionSettingsResult is the structured output of the session-settings tool.
+type SessionSettingsResult struct {
+	SessionID string           `json:"sessionId" jsonschema:"The Mcp-Session-Id of the session"`
+	Settings  session.Settings `json:"settings" jsonschema:"The settings of the session, after the requested changes"`
+	Bookmarks []string         `json:"bookmarks" jsonschema:"The causal bookmarks of the session, the queries of the session see the changes they cover"`
+}
+
+func SessionSettingsSpec() mcp.Tool {
+	return mcp.NewTool("session-settings",
+		mcp.WithDescription(`
+		Read and change the settings of the current session, they apply to every following tool call of the session.
+		Call it without arguments to read the settings.
+		- database: the database targeted when a call names none.
+		- resultFormat: "json" or "compact", the compact format lists the columns once and is cheaper for large results.
+		- readOnly: refuse the tools modifying the database, a read-only session cannot be switched back.
+		- bookmarks: the causal bookmarks, pass the bookmarks of another session to read its changes.`),
+		mcp.WithInputSchema[SessionSettingsInput](),
+		mcp.WithOutputSchema[SessionSettingsResult](),
+		mcp.WithTitleAnnotation("Session Settings"),
+		// It changes the session state, not the database: the read-only sessions allow it by name
+		mcp.WithReadOnlyHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+}
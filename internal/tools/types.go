@@ -4,7 +4,12 @@
 package tools
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/neo4j/mcp/internal/analytics"
+	"github.com/neo4j/mcp/internal/config"
 	"github.com/neo4j/mcp/internal/database"
 )
 
@@ -13,4 +18,26 @@ type ToolDependencies struct {
 	DBService        database.Service
 	AnalyticsService analytics.Service
 	SchemaSampleSize int
+	SchemaRichOutput bool     // Default of the get-schema rich argument
+	AllowedDatabases []string // Databases tools may target, see config.Config.TargetableDatabases
+	GDSInstalled     bool     // Whether the Graph Data Science library was detected
+	APOCInstalled    bool     // Whether apoc.meta.schema was detected, get-schema uses the core schema procedures otherwise
+	MaxResultRows    int      // Maximum number of rows returned by the query tools, 0 for no limit
+	// WriteConfirmationThreshold is the number of changes above which write-cypher asks the user to confirm, 0 disables the confirmation
+	WriteConfirmationThreshold int
+	// DenyUnconfirmedWrites refuses the writes above the threshold when the client cannot ask the user to confirm them
+	DenyUnconfirmedWrites bool
+}
+
+// WithTargetDatabase returns a context targeting the requested database, after checking it against AllowedDatabases.
+// An empty name returns the context untouched, so the database selected by the transport
+// (e.g. the X-Neo4j-Database HTTP header) or the configured default database applies.
+func (d *ToolDependencies) WithTargetDatabase(ctx context.Context, name string) (context.Context, error) {
+	if name == "" {
+		return ctx, nil
+	}
+	if !config.IsDatabaseAllowed(d.AllowedDatabases, name) {
+		return ctx, fmt.Errorf("database %q is not allowed, allowed databases: %s", name, strings.Join(d.AllowedDatabases, ", "))
+	}
+	return database.WithTargetDatabase(ctx, name), nil
 }
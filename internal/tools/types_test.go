@@ -0,0 +1,43 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+package tools_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/neo4j/mcp/internal/database"
+	"github.com/neo4j/mcp/internal/tools"
+)
+
+func TestToolDependencies_WithTargetDatabase(t *testing.T) {
+	deps := &tools.ToolDependencies{AllowedDatabases: []string{"neo4j", "movies"}}
+
+	t.Run("empty name keeps the context untouched", func(t *testing.T) {
+		ctx, err := deps.WithTargetDatabase(context.Background(), "")
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if _, ok := database.GetTargetDatabase(ctx); ok {
+			t.Error("Expected no target database in context")
+		}
+	})
+
+	t.Run("allowed database", func(t *testing.T) {
+		ctx, err := deps.WithTargetDatabase(context.Background(), "movies")
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if db, ok := database.GetTargetDatabase(ctx); !ok || db != "movies" {
+			t.Errorf("Expected target database 'movies', got %q", db)
+		}
+	})
+
+	t.Run("database not allowed", func(t *testing.T) {
+		_, err := deps.WithTargetDatabase(context.Background(), "sales")
+		if err == nil {
+			t.Error("Expected error for database not in the allowlist")
+		}
+	})
+}
@@ -47,6 +47,9 @@ func TestServerInitializationE2E(t *testing.T) {
 		// Verify capabilities
 		assert.NotNil(t, initResponse.Capabilities)
 		assert.NotNil(t, initResponse.Capabilities.Tools)
+		assert.NotNil(t, initResponse.Capabilities.Resources)
+		assert.NotNil(t, initResponse.Capabilities.Prompts)
+		assert.NotNil(t, initResponse.Capabilities.Completions)
 
 		t.Log("Server initialized successfully with expected name and capabilities")
 	})
@@ -104,7 +107,7 @@ func TestServerInitializationE2E(t *testing.T) {
 				t.Fatal("write-cypher tool found using readonly mode")
 			}
 		}
-		assert.Len(t, listToolsResponse.Tools, 3, "read-only mode true returns the wrong number of tools")
+		assert.Len(t, listToolsResponse.Tools, 12, "read-only mode true returns the wrong number of tools")
 	})
 
 	t.Run("initialization with read-only mode disabled", func(t *testing.T) {
@@ -131,7 +134,7 @@ func TestServerInitializationE2E(t *testing.T) {
 
 		listToolsResponse, err := mcpClient.ListTools(ctx, mcp.ListToolsRequest{})
 		require.NoError(t, err, "failed to list tools with read-only mode as false")
-		assert.Len(t, listToolsResponse.Tools, 4, "read-only mode false returns the wrong number of tools")
+		assert.Len(t, listToolsResponse.Tools, 13, "read-only mode false returns the wrong number of tools")
 	})
 	t.Run("initialization with telemetry disabled", func(t *testing.T) {
 		t.Parallel()
@@ -251,7 +254,7 @@ func TestServerInitializationE2E(t *testing.T) {
 		// (tools/list response shape: name, description, inputSchema with type/properties/required, and tool annotations).
 		expected := map[string]toolExpectation{
 			"read-cypher": {
-				description: "read-cypher can run only read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...), schema/admin commands, or PROFILE queries, use write-cypher instead.",
+				description: "read-cypher can run only read-only Cypher statements. For write operations (CREATE, MERGE, DELETE, SET, etc...) or schema/admin commands, use write-cypher instead. To profile a read-only query, use profile-cypher.",
 				annotations: mcp.ToolAnnotation{
 					Title:           "Read Cypher",
 					ReadOnlyHint:    mcp.ToBoolPtr(true),
@@ -259,11 +262,112 @@ func TestServerInitializationE2E(t *testing.T) {
 					IdempotentHint:  mcp.ToBoolPtr(true),
 					OpenWorldHint:   mcp.ToBoolPtr(true),
 				},
+				properties: map[string]propertyExpectation{
+					"query":    {jsonSchemaType: "string", required: true},
+					"params":   {jsonSchemaType: "object", required: false},
+					"database": {jsonSchemaType: "string", required: false},
+				},
+			},
+			"explain-cypher": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Explain Cypher",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
 				properties: map[string]propertyExpectation{
 					"query":  {jsonSchemaType: "string", required: true},
 					"params": {jsonSchemaType: "object", required: false},
 				},
 			},
+			"profile-cypher": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Profile Cypher",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{
+					"query":           {jsonSchemaType: "string", required: true},
+					"params":          {jsonSchemaType: "object", required: false},
+					"suppressResults": {jsonSchemaType: "boolean", required: false},
+				},
+			},
+			"vector-search": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Vector Search",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{
+					"indexName": {jsonSchemaType: "string", required: true},
+					"vector":    {required: true}, // slices are advertised as a nullable array
+					"topK":      {jsonSchemaType: "integer", required: false},
+					"filter":    {jsonSchemaType: "object", required: false},
+					"database":  {jsonSchemaType: "string", required: false},
+				},
+			},
+			"fulltext-search": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Full-text Search",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{
+					"indexName": {jsonSchemaType: "string", required: false},
+					"query":     {jsonSchemaType: "string", required: false},
+					"lucene":    {jsonSchemaType: "boolean", required: false},
+					"limit":     {jsonSchemaType: "integer", required: false},
+					"skip":      {jsonSchemaType: "integer", required: false},
+					"database":  {jsonSchemaType: "string", required: false},
+				},
+			},
+			"get-neighborhood": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Get Node Neighborhood",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{
+					"elementId":         {jsonSchemaType: "string", required: false},
+					"label":             {jsonSchemaType: "string", required: false},
+					"key":               {jsonSchemaType: "string", required: false},
+					"value":             {required: false}, // any JSON value
+					"depth":             {jsonSchemaType: "integer", required: false},
+					"relationshipTypes": {required: false}, // slices are advertised as a nullable array
+					"direction":         {jsonSchemaType: "string", required: false},
+					"maxNodes":          {jsonSchemaType: "integer", required: false},
+					"database":          {jsonSchemaType: "string", required: false},
+				},
+			},
+			"find-paths": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Find Paths",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{
+					"from":              {jsonSchemaType: "object", required: true},
+					"to":                {jsonSchemaType: "object", required: true},
+					"algorithm":         {jsonSchemaType: "string", required: false},
+					"maxLength":         {jsonSchemaType: "integer", required: false},
+					"limit":             {jsonSchemaType: "integer", required: false},
+					"relationshipTypes": {required: false}, // slices are advertised as a nullable array
+					"direction":         {jsonSchemaType: "string", required: false},
+					"weightProperty":    {jsonSchemaType: "string", required: false},
+					"database":          {jsonSchemaType: "string", required: false},
+				},
+			},
 			"write-cypher": {
 				description: "write-cypher executes any arbitrary Cypher query, with write access, against the user-configured Neo4j database.",
 				annotations: mcp.ToolAnnotation{
@@ -274,8 +378,9 @@ func TestServerInitializationE2E(t *testing.T) {
 					OpenWorldHint:   mcp.ToBoolPtr(true),
 				},
 				properties: map[string]propertyExpectation{
-					"query":  {jsonSchemaType: "string", required: true},
-					"params": {jsonSchemaType: "object", required: false},
+					"query":    {jsonSchemaType: "string", required: true},
+					"params":   {jsonSchemaType: "object", required: false},
+					"database": {jsonSchemaType: "string", required: false},
 				},
 			},
 			"get-schema": {
@@ -286,6 +391,48 @@ func TestServerInitializationE2E(t *testing.T) {
 					IdempotentHint:  mcp.ToBoolPtr(true),
 					OpenWorldHint:   mcp.ToBoolPtr(true),
 				},
+				properties: map[string]propertyExpectation{
+					"database":          {jsonSchemaType: "string", required: false},
+					"includeStats":      {jsonSchemaType: "boolean", required: false},
+					"rich":              {required: false}, // pointers are advertised as a nullable boolean
+					"labels":            {required: false}, // slices are advertised as a nullable array
+					"relationshipTypes": {required: false}, // slices are advertised as a nullable array
+					"neighborsOf":       {jsonSchemaType: "string", required: false},
+					"includeProperties": {required: false}, // pointers are advertised as a nullable boolean
+					"sampleSize":        {jsonSchemaType: "integer", required: false},
+					"refresh":           {jsonSchemaType: "boolean", required: false},
+				},
+			},
+			"get-graph-stats": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Get Graph Statistics",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{
+					"database": {jsonSchemaType: "string", required: false},
+				},
+			},
+			"list-databases": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "List Neo4j Databases",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
+				properties: map[string]propertyExpectation{},
+			},
+			"get-indexes-and-constraints": {
+				annotations: mcp.ToolAnnotation{
+					Title:           "Get Neo4j Indexes and Constraints",
+					ReadOnlyHint:    mcp.ToBoolPtr(true),
+					DestructiveHint: mcp.ToBoolPtr(false),
+					IdempotentHint:  mcp.ToBoolPtr(true),
+					OpenWorldHint:   mcp.ToBoolPtr(true),
+				},
 				properties: map[string]propertyExpectation{},
 			},
 			"list-gds-procedures": {
@@ -376,6 +523,12 @@ func TestServerInitializationE2E(t *testing.T) {
 					"inputSchema.required for %q does not match spec-declared required fields",
 					name)
 
+				// Every tool returns structured content described by an output schema
+				assert.Equalf(t, "object", tool.OutputSchema.Type,
+					"outputSchema.type for %q must be \"object\" per MCP spec", name)
+				assert.NotEmptyf(t, tool.OutputSchema.Properties,
+					"outputSchema.properties for %q must be present", name)
+
 			})
 		}
 
@@ -0,0 +1,51 @@
+// Copyright (c) "Neo4j"
+// Neo4j Sweden AB [http://neo4j.com]
+
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/neo4j/mcp/internal/tools/cypher"
+	"github.com/neo4j/mcp/test/integration/helpers"
+)
+
+func TestCancellation(t *testing.T) {
+	t.Parallel()
+	tc := helpers.NewTestContext(t, dbs.GetDriver())
+
+	// The alias makes the query of this test recognisable in SHOW TRANSACTIONS
+	alias := "count_" + tc.TestID
+	query := "UNWIND range(1, 10000000000) AS x WITH x WHERE x < 0 RETURN count(x) AS " + alias
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(time.Second, cancel)
+
+	read := cypher.ReadCypherHandler(tc.Deps)
+	start := time.Now()
+	res, err := read(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"query": query}}})
+	if err != nil {
+		t.Fatalf("tool call failed: %v", err)
+	}
+	if res == nil || !res.IsError {
+		t.Fatalf("expected the cancelled query to fail, got %+v", res)
+	}
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Errorf("expected the call to return soon after the cancellation, took %v", elapsed)
+	}
+
+	records, err := tc.Service.ExecuteReadQuery(context.Background(),
+		"SHOW TRANSACTIONS YIELD transactionId, currentQuery, status WHERE currentQuery CONTAINS $alias AND NOT status STARTS WITH 'Terminated' RETURN transactionId",
+		map[string]any{"alias": alias})
+	if err != nil {
+		t.Fatalf("failed to list transactions: %v", err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected the transaction of the cancelled query to be terminated, found %v", records)
+	}
+}
@@ -44,7 +44,7 @@ func TestGetSchema(t *testing.T) {
 		t.Fatalf("failed to seed Company node: %v", err)
 	}
 
-	getSchema := cypher.GetSchemaHandler(tc.Deps, 100)
+	getSchema := cypher.GetSchemaHandler(tc.Deps, 100, nil)
 	res := tc.CallTool(getSchema, nil)
 
 	var schemaEntries []SchemaItem
@@ -29,8 +29,10 @@ func TestIssue70(t *testing.T) {
 			handler: cypher.ReadCypherHandler,
 		},
 		{
-			name:    "write-cypher",
-			handler: cypher.WriteCypherHandler,
+			name: "write-cypher",
+			handler: func(deps *tools.ToolDependencies) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return cypher.WriteCypherHandler(deps, nil)
+			},
 		},
 	}
 
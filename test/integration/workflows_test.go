@@ -18,7 +18,7 @@ func TestWriteThenRead(t *testing.T) {
 
 	companyLabel := tc.GetUniqueLabel("Company")
 
-	write := cypher.WriteCypherHandler(tc.Deps)
+	write := cypher.WriteCypherHandler(tc.Deps, nil)
 	tc.CallTool(write, map[string]any{
 		"query":  "CREATE (c:" + companyLabel + " {name: $name, industry: $industry}) RETURN c",
 		"params": map[string]any{"name": "Neo4j", "industry": "Database"},
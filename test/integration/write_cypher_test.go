@@ -6,6 +6,7 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
 	"github.com/neo4j/mcp/internal/tools/cypher"
@@ -18,7 +19,7 @@ func TestWriteCypher(t *testing.T) {
 
 	personLabel := tc.GetUniqueLabel("Person")
 
-	write := cypher.WriteCypherHandler(tc.Deps)
+	write := cypher.WriteCypherHandler(tc.Deps, nil)
 	tc.CallTool(write, map[string]any{
 		"query":  "CREATE (p:" + personLabel + " {name: $name}) RETURN p",
 		"params": map[string]any{"name": "Alice"},
@@ -26,3 +27,24 @@ func TestWriteCypher(t *testing.T) {
 
 	tc.VerifyNodeInDB(personLabel, map[string]any{"name": "Alice"})
 }
+
+func TestDryRunWriteQuery(t *testing.T) {
+	t.Parallel()
+	tc := helpers.NewTestContext(t, dbs.GetDriver())
+
+	personLabel, err := tc.SeedNode("Person", map[string]any{"name": "Alice"})
+	if err != nil {
+		t.Fatalf("failed to seed data: %v", err)
+	}
+
+	summary, err := tc.Service.DryRunWriteQuery(context.Background(), "MATCH (p:"+personLabel.String()+") DETACH DELETE p", nil)
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	if deleted := summary.Counters().NodesDeleted(); deleted != 1 {
+		t.Errorf("expected the dry run to report 1 deleted node, got %d", deleted)
+	}
+
+	// The dry run is rolled back
+	tc.VerifyNodeInDB(personLabel, map[string]any{"name": "Alice"})
+}